@@ -0,0 +1,112 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/google/uuid"
+)
+
+const (
+	// Staging label attached to versions written by CheckAndSetSecret until they become current.
+	checkAndSetStage = "SECRETPROVIDERCAS"
+)
+
+// CheckAndSetSecret creates or updates a secret only if its current version matches the expected version.
+// An empty expected version requires that the secret does not exist. A mismatch returns ErrConflict.
+// New versions are staged first, then made current by moving the AWSCURRENT staging label away from the expected version, which fails if another writer got there first.
+func (a *AWSSecretsManager) CheckAndSetSecret(ctx context.Context, path string, data map[string]interface{}, expectedVersion string) (version string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	if path == "" {
+		return "", errors.New("path is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// Serialize data.
+	dataBytes, err := json.Marshal(&data)
+	if err != nil {
+		return "", err
+	}
+	version = uuid.New().String()
+
+	// Create the secret if it must not exist.
+	if expectedVersion == "" {
+		_, err = a.secretsManager.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
+			ClientRequestToken: &version,
+			Name:               &path,
+			SecretBinary:       dataBytes,
+		})
+		err = wrapError(path, err)
+		if errors.Is(err, secretprovidertype.ErrAlreadyExists) {
+			return "", secretprovidertype.NewError(secretprovidertype.ErrConflict, path, err)
+		}
+		if err != nil {
+			return "", err
+		}
+	} else {
+		// Compare versions before staging a new one.
+		metadata, err := a.DescribeSecret(ctx, path)
+		if err != nil {
+			return "", err
+		}
+		if metadata.Version != expectedVersion {
+			return "", secretprovidertype.NewError(secretprovidertype.ErrConflict, path, errors.New("expected version "+expectedVersion+" but found "+metadata.Version))
+		}
+
+		// Stage the new version.
+		_, err = a.secretsManager.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+			ClientRequestToken: &version,
+			SecretBinary:       dataBytes,
+			SecretId:           &path,
+			VersionStages:      aws.StringSlice([]string{checkAndSetStage}),
+		})
+		if err != nil {
+			return "", wrapError(path, err)
+		}
+
+		// Make the new version current.
+		_, err = a.secretsManager.UpdateSecretVersionStageWithContext(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+			MoveToVersionId:     &version,
+			RemoveFromVersionId: &expectedVersion,
+			SecretId:            &path,
+			VersionStage:        aws.String(currentStage),
+		})
+		if err != nil {
+			// Release the staged version so that it can be deprecated.
+			a.secretsManager.UpdateSecretVersionStageWithContext(ctx, &secretsmanager.UpdateSecretVersionStageInput{ // nolint
+				RemoveFromVersionId: &version,
+				SecretId:            &path,
+				VersionStage:        aws.String(checkAndSetStage),
+			})
+
+			var awsError awserr.Error
+			if errors.As(err, &awsError) && awsError.Code() == secretsmanager.ErrCodeInvalidParameterException {
+				return "", secretprovidertype.NewError(secretprovidertype.ErrConflict, path, err)
+			}
+
+			return "", wrapError(path, err)
+		}
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Upserted secret: "+path)
+	} else {
+		logger.Info(ctx, "Upserted secret.")
+	}
+
+	return version, nil
+}
@@ -0,0 +1,32 @@
+package awssecretsmanager
+
+import (
+	"log"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilstrings "github.com/bertjohnson/util/strings"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckAndSetSecret tests CheckAndSetSecret().
+func TestCheckAndSetSecret(t *testing.T) {
+	secretID, err := utilstrings.HexToBase58(uuid.New().String())
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	secretPath := "unittests/checkandset/" + secretID
+
+	// Create a secret that must not exist.
+	version, err := awsSecretsManager.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "one"}, "")
+	assert.NoError(t, err)
+	_, err = awsSecretsManager.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "one"}, "")
+	assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+
+	// Update from the current version, then from a stale version.
+	_, err = awsSecretsManager.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "two"}, version)
+	assert.NoError(t, err)
+	_, err = awsSecretsManager.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "three"}, version)
+	assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+}
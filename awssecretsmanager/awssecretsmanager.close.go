@@ -0,0 +1,7 @@
+package awssecretsmanager
+
+// Close releases resources held by the secret provider.
+// AWS Secrets Manager clients hold no background resources, so Close does nothing.
+func (a *AWSSecretsManager) Close() error {
+	return nil
+}
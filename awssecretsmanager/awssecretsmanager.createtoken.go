@@ -3,9 +3,12 @@ package awssecretsmanager
 import (
 	"context"
 	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 )
 
 // CreateToken creates a token.
-func (a *AWSSecretsManager) CreateToken(ctx context.Context, id string, displayName string, numUses int, policies []string) (token string, err error) {
-	return "", errors.New("not implemented")
+// Tokens are not supported by AWS Secrets Manager.
+func (a *AWSSecretsManager) CreateToken(ctx context.Context, options *secretprovidertype.TokenOptions) (token *secretprovidertype.Token, err error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by AWS Secrets Manager"))
 }
@@ -2,8 +2,13 @@ package awssecretsmanager
 
 import (
 	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
 )
 
 // TestCreateToken tests CreateToken.
 func TestCreateToken(t *testing.T) {
+	_, err := awsSecretsManager.CreateToken(ctx, &secretprovidertype.TokenOptions{})
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotSupported)
 }
@@ -29,7 +29,7 @@ func (a *AWSSecretsManager) DeleteSecret(ctx context.Context, path string) error
 		SecretId: &path,
 	})
 	if err != nil {
-		return err
+		return wrapError(path, err)
 	}
 
 	// Log.
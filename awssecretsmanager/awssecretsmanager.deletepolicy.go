@@ -0,0 +1,14 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// DeletePolicy deletes a policy.
+// Policies are not supported by AWS Secrets Manager, which uses IAM for access control.
+func (a *AWSSecretsManager) DeletePolicy(ctx context.Context, name string) error {
+	return secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("policies are not supported by AWS Secrets Manager"))
+}
@@ -0,0 +1,81 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+const (
+	// Staging label of the current secret version.
+	currentStage = "AWSCURRENT"
+)
+
+// DescribeSecret returns a secret's metadata without reading its data.
+func (a *AWSSecretsManager) DescribeSecret(ctx context.Context, path string) (metadata *secretprovidertype.SecretMetadata, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// Describe secret.
+	output, err := a.secretsManager.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: &path,
+	})
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	metadata = toSecretMetadata(output)
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Described secret: "+path)
+	} else {
+		logger.Verbose(ctx, "Described secret.")
+	}
+
+	return metadata, nil
+}
+
+// toSecretMetadata converts the output of DescribeSecret.
+func toSecretMetadata(output *secretsmanager.DescribeSecretOutput) *secretprovidertype.SecretMetadata {
+	metadata := &secretprovidertype.SecretMetadata{
+		Created:     output.CreatedDate,
+		Description: aws.StringValue(output.Description),
+		Updated:     output.LastChangedDate,
+	}
+	for _, tag := range output.Tags {
+		key := aws.StringValue(tag.Key)
+		if key == secretprovidertype.MetadataOwnerKey {
+			metadata.Owner = aws.StringValue(tag.Value)
+
+			continue
+		}
+		if metadata.Tags == nil {
+			metadata.Tags = make(map[string]string)
+		}
+		metadata.Tags[key] = aws.StringValue(tag.Value)
+	}
+	for versionID, stages := range output.VersionIdsToStages {
+		for _, stage := range stages {
+			if aws.StringValue(stage) == currentStage {
+				metadata.Version = versionID
+			}
+		}
+	}
+
+	return metadata
+}
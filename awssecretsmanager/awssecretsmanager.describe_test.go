@@ -0,0 +1,34 @@
+package awssecretsmanager
+
+import (
+	"log"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilstrings "github.com/bertjohnson/util/strings"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDescribeSecret tests DescribeSecret().
+func TestDescribeSecret(t *testing.T) {
+	secretID, err := utilstrings.HexToBase58(uuid.New().String())
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	secretPath := "unittests/describe/" + secretID
+	err = awsSecretsManager.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+	assert.NoError(t, err)
+
+	// Describe existing secret.
+	metadata, err := awsSecretsManager.DescribeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, metadata) {
+		assert.NotNil(t, metadata.Created)
+		assert.NotEqual(t, "", metadata.Version)
+	}
+
+	// Describe nonexistent secret.
+	_, err = awsSecretsManager.DescribeSecret(ctx, "secret/nonexistent/path")
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
@@ -0,0 +1,34 @@
+package awssecretsmanager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// wrapError classifies an AWS error using the shared secret provider errors.
+// Errors that cannot be classified are returned unchanged.
+func wrapError(path string, err error) error {
+	var awsError awserr.Error
+	if !errors.As(err, &awsError) {
+		return err
+	}
+
+	switch awsError.Code() {
+	case secretsmanager.ErrCodeResourceNotFoundException:
+		return secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, err)
+	case secretsmanager.ErrCodeResourceExistsException:
+		return secretprovidertype.NewError(secretprovidertype.ErrAlreadyExists, path, err)
+	case secretsmanager.ErrCodeInvalidRequestException:
+		return secretprovidertype.NewError(secretprovidertype.ErrConflict, path, err)
+	case "AccessDeniedException", "UnrecognizedClientException":
+		return secretprovidertype.NewError(secretprovidertype.ErrPermissionDenied, path, err)
+	case secretsmanager.ErrCodeInternalServiceError, request.ErrCodeRequestError, request.ErrCodeResponseTimeout, "ThrottlingException", "ServiceUnavailable":
+		return secretprovidertype.NewError(secretprovidertype.ErrUnavailable, path, err)
+	default:
+		return err
+	}
+}
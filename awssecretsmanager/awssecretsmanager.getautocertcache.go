@@ -38,7 +38,7 @@ func (a AutoCertCache) Get(ctx context.Context, name string) ([]byte, error) {
 		return nil, errors.New("name is required")
 	}
 	if strings.Contains(name, "/") {
-		return nil, errors.New("name cannot traverse directories")
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	// Add to context.
@@ -49,10 +49,11 @@ func (a AutoCertCache) Get(ctx context.Context, name string) ([]byte, error) {
 	secret, err := a.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
 		SecretId: &path,
 	})
-	if err != nil {
+	err = wrapError(path, err)
+	if err != nil && !errors.Is(err, secretprovidertype.ErrNotFound) {
 		return nil, err
 	}
-	if secret == nil {
+	if secret == nil || err != nil {
 		if os.Getenv(env.Debug) != "" {
 			logger.Verbose(ctx, "Secret does not exist: "+path)
 		} else {
@@ -86,7 +87,7 @@ func (a AutoCertCache) Put(ctx context.Context, name string, data []byte) error
 		return errors.New("name is required")
 	}
 	if strings.Contains(name, "/") {
-		return errors.New("name cannot traverse directories")
+		return secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	// Add to context.
@@ -98,12 +99,14 @@ func (a AutoCertCache) Put(ctx context.Context, name string, data []byte) error
 		SecretBinary: data,
 		SecretId:     &path,
 	})
+	err = wrapError(path, err)
 	// If the secret does not exist, create it.
-	if err != nil && strings.HasPrefix(err.Error(), "ResourceNotFoundException") {
+	if errors.Is(err, secretprovidertype.ErrNotFound) {
 		_, err = a.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
 			Name:         &path,
 			SecretBinary: data,
 		})
+		err = wrapError(path, err)
 	}
 	if err != nil {
 		return err
@@ -129,7 +132,7 @@ func (a AutoCertCache) Delete(ctx context.Context, name string) error {
 		return errors.New("name is required")
 	}
 	if strings.Contains(name, "/") {
-		return errors.New("name cannot traverse directories")
+		return secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	// Add to context.
@@ -140,7 +143,8 @@ func (a AutoCertCache) Delete(ctx context.Context, name string) error {
 	_, err := a.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
 		SecretId: &path,
 	})
-	if err != nil {
+	err = wrapError(path, err)
+	if err != nil && !errors.Is(err, secretprovidertype.ErrNotFound) {
 		return err
 	}
 
@@ -25,9 +25,21 @@ type AWSSecretsManager struct {
 
 var (
 	// Marshaller.
-	json jsoniter.API
+	json = jsoniter.ConfigCompatibleWithStandardLibrary
 )
 
+// init registers the AWSSecretsManager secret provider type.
+func init() {
+	secretprovidertype.Register("awssecretsmanager", func(ctx context.Context, secretProvider *secretprovidertype.SecretProvider) (secretprovidertype.ISecretProvider, error) {
+		awsSecretsManagerClient, err := New(ctx, secretProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return awsSecretsManagerClient, nil
+	})
+}
+
 // New creates a matching secret store implementation.
 func New(ctx context.Context, secretStore *secretprovidertype.SecretProvider) (*AWSSecretsManager, error) {
 	// Validate parameters.
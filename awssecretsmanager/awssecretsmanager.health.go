@@ -0,0 +1,39 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Health reports whether AWS Secrets Manager can list secrets.
+func (a *AWSSecretsManager) Health(ctx context.Context) (health *secretprovidertype.Health, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// List a secret.
+	health = &secretprovidertype.Health{
+		Checked: time.Now(),
+	}
+	_, err = a.secretsManager.ListSecretsWithContext(ctx, &secretsmanager.ListSecretsInput{
+		MaxResults: aws.Int64(1),
+	})
+	if err != nil {
+		health.Error = wrapError("", err).Error()
+
+		return health, nil
+	}
+	health.Healthy = true
+
+	return health, nil
+}
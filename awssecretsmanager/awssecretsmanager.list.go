@@ -3,44 +3,120 @@ package awssecretsmanager
 import (
 	"context"
 	"errors"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 )
 
-// ListSecrets lists secret paths.
-func (a *AWSSecretsManager) ListSecrets(ctx context.Context, pathChannel chan string, errorChannel chan error) {
+// ListSecrets lists secret paths. Folder entries are only reported by WalkSecretPaths.
+func (a *AWSSecretsManager) ListSecrets(ctx context.Context, options *secretprovidertype.ListOptions, pathChannel chan string, errorChannel chan error) {
+	secretprovidertype.StreamSecretPaths(ctx, options, a.WalkSecretPaths, pathChannel, errorChannel)
+}
+
+// WalkSecretPaths calls fn for each secret path.
+// Secret names are treated as paths separated by "/"; folders are derived from the names.
+func (a *AWSSecretsManager) WalkSecretPaths(ctx context.Context, options *secretprovidertype.ListOptions, fn secretprovidertype.PathWalkFunc) error {
 	// Validate parameters.
 	if ctx == nil {
-		errorChannel <- errors.New("context is required")
-
-		close(pathChannel)
-		close(errorChannel)
-
-		return
+		return errors.New("context is required")
+	}
+	if fn == nil {
+		return errors.New("walk function is required")
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
 
-	// List secrets.
-	secrets, err := a.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{})
-	if err != nil {
-		errorChannel <- err
+	// Build filters.
+	folder := options.Folder()
+	input := &secretsmanager.ListSecretsInput{}
+	if folder != "" {
+		input.Filters = append(input.Filters, &secretsmanager.Filter{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeName),
+			Values: []*string{aws.String(folder)},
+		})
+	}
+	if options != nil {
+		for key, value := range options.Tags {
+			input.Filters = append(input.Filters, &secretsmanager.Filter{
+				Key:    aws.String(secretsmanager.FilterNameStringTypeTagKey),
+				Values: []*string{aws.String(key)},
+			}, &secretsmanager.Filter{
+				Key:    aws.String(secretsmanager.FilterNameStringTypeTagValue),
+				Values: []*string{aws.String(value)},
+			})
+		}
+		if options.IncludeDeleted {
+			input.IncludePlannedDeletion = aws.Bool(true)
+		}
+	}
 
-		close(pathChannel)
-		close(errorChannel)
+	// List secrets.
+	folders := make(map[string]bool)
+	var walkErr error
+	err := a.secretsManager.ListSecretsPagesWithContext(ctx, input, func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+		for _, secret := range page.SecretList {
+			walkErr = a.walkSecretListEntry(ctx, options, folder, folders, secret, fn)
+			if walkErr != nil {
+				return false
+			}
+		}
 
-		return
+		return true
+	})
+	if walkErr == secretprovidertype.StopWalk {
+		return nil
+	}
+	if walkErr != nil {
+		return walkErr
 	}
-	for _, secret := range secrets.SecretList {
-		pathChannel <- *secret.Name
+	if err != nil {
+		return wrapError(folder, err)
 	}
 
 	// Log.
 	logger.Verbose(ctx, "Listed secrets.")
 
-	close(pathChannel)
-	close(errorChannel)
+	return nil
+}
+
+// walkSecretListEntry calls fn for a listed secret, or for its folder if listing non-recursively.
+// Folders already reported are tracked in folders.
+func (a *AWSSecretsManager) walkSecretListEntry(ctx context.Context, options *secretprovidertype.ListOptions, folder string, folders map[string]bool, secret *secretsmanager.SecretListEntry, fn secretprovidertype.PathWalkFunc) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Filter by folder and tags.
+	// Tag key and value filters match independently, so pairs are confirmed here.
+	name := aws.StringValue(secret.Name)
+	if !strings.HasPrefix(name, folder) {
+		return nil
+	}
+	tags := make(map[string]string, len(secret.Tags))
+	for _, tag := range secret.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	if !options.MatchesTags(tags) {
+		return nil
+	}
+
+	// Report folder.
+	if !options.IsRecursive() {
+		if slash := strings.Index(name[len(folder):], "/"); slash >= 0 {
+			subfolder := name[0 : len(folder)+slash+1]
+			if folders[subfolder] {
+				return nil
+			}
+			folders[subfolder] = true
+
+			return fn(subfolder, true, nil)
+		}
+	}
+
+	return fn(name, false, nil)
 }
@@ -0,0 +1,52 @@
+package awssecretsmanager
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWalkSecretPaths tests WalkSecretPaths().
+func TestWalkSecretPaths(t *testing.T) {
+	for _, secretPath := range []string{"listtree/top", "listtree/app/one"} {
+		err := awsSecretsManager.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+		assert.NoError(t, err)
+	}
+
+	// List a folder recursively.
+	var paths []string
+	err := awsSecretsManager.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{Prefix: "listtree"}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		paths = append(paths, path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"listtree/top", "listtree/app/one"}, paths)
+
+	// List a folder non-recursively.
+	var folders []string
+	paths = nil
+	err = awsSecretsManager.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{NonRecursive: true, Prefix: "listtree"}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		if folder {
+			folders = append(folders, path)
+		} else {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"listtree/app/"}, folders)
+	assert.Equal(t, []string{"listtree/top"}, paths)
+
+	// List secrets including those scheduled for deletion.
+	err = awsSecretsManager.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{IncludeDeleted: true}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,14 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ListPolicies lists the names of policies.
+// Policies are not supported by AWS Secrets Manager, which uses IAM for access control.
+func (a *AWSSecretsManager) ListPolicies(ctx context.Context) (names []string, err error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("policies are not supported by AWS Secrets Manager"))
+}
@@ -0,0 +1,65 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ListSecretVersions lists the versions of a secret, oldest first.
+func (a *AWSSecretsManager) ListSecretVersions(ctx context.Context, path string) (versions []*secretprovidertype.SecretVersion, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// List versions.
+	err = a.secretsManager.ListSecretVersionIdsPagesWithContext(ctx, &secretsmanager.ListSecretVersionIdsInput{
+		SecretId: &path,
+	}, func(page *secretsmanager.ListSecretVersionIdsOutput, lastPage bool) bool {
+		for _, entry := range page.Versions {
+			version := &secretprovidertype.SecretVersion{
+				Created: entry.CreatedDate,
+				Labels:  aws.StringValueSlice(entry.VersionStages),
+				Version: aws.StringValue(entry.VersionId),
+			}
+			for _, label := range version.Labels {
+				if label == currentStage {
+					version.Current = true
+				}
+			}
+			versions = append(versions, version)
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return aws.TimeValue(versions[i].Created).Before(aws.TimeValue(versions[j].Created))
+	})
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Listed secret versions: "+path)
+	} else {
+		logger.Verbose(ctx, "Listed secret versions.")
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,44 @@
+package awssecretsmanager
+
+import (
+	"log"
+	"testing"
+
+	utilstrings "github.com/bertjohnson/util/strings"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSecretVersions tests ListSecretVersions(), ReadSecretVersion() and RollbackSecret().
+func TestSecretVersions(t *testing.T) {
+	secretID, err := utilstrings.HexToBase58(uuid.New().String())
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	secretPath := "unittests/versions/" + secretID
+	for _, value := range []string{"one", "two"} {
+		err = awsSecretsManager.UpsertSecret(ctx, secretPath, map[string]interface{}{"value": value})
+		assert.NoError(t, err)
+	}
+
+	// List versions.
+	versions, err := awsSecretsManager.ListSecretVersions(ctx, secretPath)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	// Read the previous version by staging label.
+	secret, err := awsSecretsManager.ReadSecretVersion(ctx, secretPath, "AWSPREVIOUS")
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, "one", secret.Data["value"])
+	}
+
+	// Roll back to the previous version.
+	err = awsSecretsManager.RollbackSecret(ctx, secretPath, "AWSPREVIOUS")
+	assert.NoError(t, err)
+	secret, err = awsSecretsManager.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, "one", secret.Data["value"])
+	}
+}
@@ -0,0 +1,59 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+const (
+	// Maximum number of attempts to patch a secret that is concurrently modified.
+	patchAttempts = 5
+)
+
+// PatchSecret updates individual keys of an existing secret using JSON merge patch semantics.
+// Keys set to nil in the patch are removed.
+// The secret is read and rewritten with CheckAndSetSecret, retrying if it is concurrently modified.
+func (a *AWSSecretsManager) PatchSecret(ctx context.Context, path string, patch map[string]interface{}) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// Read, patch and write secret.
+	var err error
+	for attempt := 0; attempt < patchAttempts; attempt++ {
+		var secret *secretprovidertype.Secret
+		secret, err = a.ReadSecret(ctx, path)
+		if err != nil {
+			return err
+		}
+		_, err = a.CheckAndSetSecret(ctx, path, secretprovidertype.MergePatch(secret.Data, patch), secret.Metadata.Version)
+		if !errors.Is(err, secretprovidertype.ErrConflict) {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Patched secret: "+path)
+	} else {
+		logger.Info(ctx, "Patched secret.")
+	}
+
+	return nil
+}
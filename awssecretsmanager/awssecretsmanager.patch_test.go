@@ -0,0 +1,54 @@
+package awssecretsmanager
+
+import (
+	"log"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilstrings "github.com/bertjohnson/util/strings"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPatchSecret tests PatchSecret().
+func TestPatchSecret(t *testing.T) {
+	secretID, err := utilstrings.HexToBase58(uuid.New().String())
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	secretPath := "unittests/patch/" + secretID
+	err = awsSecretsManager.UpsertSecret(ctx, secretPath, map[string]interface{}{
+		"keep":   "value",
+		"nested": map[string]interface{}{"keep": "value", "remove": "value"},
+		"remove": "value",
+		"update": "old",
+	})
+	assert.NoError(t, err)
+
+	// Patch the secret, merging nested objects.
+	err = awsSecretsManager.PatchSecret(ctx, secretPath, map[string]interface{}{
+		"add":    "value",
+		"nested": map[string]interface{}{"add": "value", "remove": nil},
+		"remove": nil,
+		"update": "new",
+	})
+	assert.NoError(t, err)
+	secret, err := awsSecretsManager.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, map[string]interface{}{
+			"add":    "value",
+			"keep":   "value",
+			"nested": map[string]interface{}{"add": "value", "keep": "value"},
+			"update": "new",
+		}, secret.Data)
+	}
+
+	// Patch a secret that does not exist.
+	err = awsSecretsManager.PatchSecret(ctx, secretPath+"missing", map[string]interface{}{"value": "one"})
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+
+	// Clean up.
+	err = awsSecretsManager.DeleteSecret(ctx, secretPath)
+	assert.NoError(t, err)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"os"
-	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
@@ -27,16 +27,30 @@ func (a *AWSSecretsManager) ReadSecret(ctx context.Context, path string) (secret
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
 
 	// Read secret.
-	secretValue, err := a.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+	secret, err = a.readSecretValue(ctx, path, &secretsmanager.GetSecretValueInput{
 		SecretId: &path,
 	})
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "ResourceNotFoundException") {
-			return nil, errors.New("not found")
-		}
-
 		return nil, err
 	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Read secret: "+path)
+	} else {
+		logger.Verbose(ctx, "Read secret.")
+	}
+
+	return secret, nil
+}
+
+// readSecretValue reads and deserializes a secret value, including the secret's metadata if it can be described.
+func (a *AWSSecretsManager) readSecretValue(ctx context.Context, path string, input *secretsmanager.GetSecretValueInput) (secret *secretprovidertype.Secret, err error) {
+	// Read secret.
+	secretValue, err := a.secretsManager.GetSecretValueWithContext(ctx, input)
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
 	if secretValue == nil {
 		if os.Getenv(env.Debug) != "" {
 			logger.Verbose(ctx, "Secret does not exist: "+path)
@@ -44,7 +58,7 @@ func (a *AWSSecretsManager) ReadSecret(ctx context.Context, path string) (secret
 			logger.Verbose(ctx, "Secret does not exist.")
 		}
 
-		return nil, errors.New("not found")
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, nil)
 	}
 
 	// Check if the secret is binary or a string.
@@ -59,12 +73,17 @@ func (a *AWSSecretsManager) ReadSecret(ctx context.Context, path string) (secret
 	}
 	secret.Path = path
 
-	// Log.
-	if os.Getenv(env.Debug) != "" {
-		logger.Verbose(ctx, "Read secret: "+path)
+	// Describe secret, on a best-effort basis so that reads do not depend on permission or capacity to describe secrets.
+	output, err := a.secretsManager.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: &path,
+	})
+	if err != nil {
+		logger.Warn(ctx, "Unable to describe secret: "+wrapError(path, err).Error())
+		secret.Metadata = &secretprovidertype.SecretMetadata{}
 	} else {
-		logger.Verbose(ctx, "Read secret.")
+		secret.Metadata = toSecretMetadata(output)
 	}
+	secret.Metadata.Version = aws.StringValue(secretValue.VersionId)
 
 	return secret, nil
 }
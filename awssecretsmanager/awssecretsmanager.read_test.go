@@ -4,6 +4,7 @@ import (
 	"log"
 	"testing"
 
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 	utilstrings "github.com/bertjohnson/util/strings"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -35,5 +36,5 @@ func TestReadSecret(t *testing.T) {
 	// Read nonexistent secret.
 	readSecret, err = awsSecretsManager.ReadSecret(ctx, "secret/nonexistent/path")
 	assert.Error(t, err)
-	assert.Equal(t, "not found", err)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
 }
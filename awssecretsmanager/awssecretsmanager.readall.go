@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
 	secretprovidertype "github.com/bertjohnson/secretprovider/types"
@@ -12,47 +11,42 @@ import (
 
 // ReadAllSecrets reads all secrets.
 func (a *AWSSecretsManager) ReadAllSecrets(ctx context.Context, secretChannel chan *secretprovidertype.Secret, errorChannel chan error) {
+	secretprovidertype.StreamSecrets(ctx, a.WalkSecrets, secretChannel, errorChannel)
+}
+
+// WalkSecrets calls fn for each secret.
+func (a *AWSSecretsManager) WalkSecrets(ctx context.Context, fn secretprovidertype.SecretWalkFunc) error {
 	// Validate parameters.
 	if ctx == nil {
-		errorChannel <- errors.New("context is required")
-
-		close(secretChannel)
-		close(errorChannel)
-
-		return
+		return errors.New("context is required")
+	}
+	if fn == nil {
+		return errors.New("walk function is required")
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
 
 	// Read all secrets.
-	secrets, err := a.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{})
-	if err != nil {
-		errorChannel <- err
-
-		close(secretChannel)
-		close(errorChannel)
-
-		return
-	}
-	for _, secretValue := range secrets.SecretList {
-		secret, err := a.ReadSecret(ctx, *secretValue.Name)
+	err := a.WalkSecretPaths(ctx, nil, func(path string, folder bool, err error) error {
 		if err != nil {
-			errorChannel <- err
-
-			close(secretChannel)
-			close(errorChannel)
-
-			return
+			return fn(path, nil, err)
 		}
 
 		// Return secret.
-		secretChannel <- secret
+		secret, err := a.ReadSecret(ctx, path)
+		if err != nil {
+			return fn(path, nil, err)
+		}
+
+		return fn(path, secret, nil)
+	})
+	if err != nil {
+		return err
 	}
 
 	// Log.
 	logger.Verbose(ctx, "Read all secrets.")
 
-	close(secretChannel)
-	close(errorChannel)
+	return nil
 }
@@ -0,0 +1,14 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ReadPolicy reads a policy.
+// Policies are not supported by AWS Secrets Manager, which uses IAM for access control.
+func (a *AWSSecretsManager) ReadPolicy(ctx context.Context, name string) (policy *secretprovidertype.Policy, err error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("policies are not supported by AWS Secrets Manager"))
+}
@@ -0,0 +1,62 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/google/uuid"
+)
+
+// ReadSecretVersion returns a specific version of a secret.
+// The version may be a version ID or a staging label, such as AWSPREVIOUS.
+func (a *AWSSecretsManager) ReadSecretVersion(ctx context.Context, path string, version string) (secret *secretprovidertype.Secret, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	if version == "" {
+		return nil, errors.New("version is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// Read secret version.
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: &path,
+	}
+	if isVersionID(version) {
+		input.VersionId = &version
+	} else {
+		input.VersionStage = &version
+	}
+	secret, err = a.readSecretValue(ctx, path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Read secret version: "+path+"@"+version)
+	} else {
+		logger.Verbose(ctx, "Read secret version.")
+	}
+
+	return secret, nil
+}
+
+// isVersionID returns whether a version is a version ID rather than a staging label.
+func isVersionID(version string) bool {
+	_, err := uuid.Parse(version)
+
+	return err == nil
+}
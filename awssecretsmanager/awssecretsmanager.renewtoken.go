@@ -0,0 +1,15 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RenewToken renews a token by the increment.
+// Tokens are not supported by AWS Secrets Manager.
+func (a *AWSSecretsManager) RenewToken(ctx context.Context, token string, increment time.Duration) (*secretprovidertype.Token, error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by AWS Secrets Manager"))
+}
@@ -0,0 +1,15 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RenewTokenAccessor renews a token by its accessor by the increment.
+// Tokens are not supported by AWS Secrets Manager.
+func (a *AWSSecretsManager) RenewTokenAccessor(ctx context.Context, accessor string, increment time.Duration) (*secretprovidertype.Token, error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by AWS Secrets Manager"))
+}
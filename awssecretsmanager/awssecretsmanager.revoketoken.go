@@ -0,0 +1,14 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RevokeToken revokes a token.
+// Tokens are not supported by AWS Secrets Manager.
+func (a *AWSSecretsManager) RevokeToken(ctx context.Context, token string) error {
+	return secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by AWS Secrets Manager"))
+}
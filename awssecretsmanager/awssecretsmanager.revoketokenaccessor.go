@@ -0,0 +1,14 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RevokeTokenAccessor revokes a token by its accessor.
+// Tokens are not supported by AWS Secrets Manager.
+func (a *AWSSecretsManager) RevokeTokenAccessor(ctx context.Context, accessor string) error {
+	return secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by AWS Secrets Manager"))
+}
@@ -0,0 +1,83 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RollbackSecret makes a previous version of a secret current by moving the AWSCURRENT staging label to it.
+// The version may be a version ID or a staging label, such as AWSPREVIOUS.
+func (a *AWSSecretsManager) RollbackSecret(ctx context.Context, path string, version string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	if version == "" {
+		return errors.New("version is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
+
+	// Resolve version IDs.
+	output, err := a.secretsManager.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: &path,
+	})
+	if err != nil {
+		return wrapError(path, err)
+	}
+	var currentVersionID, targetVersionID string
+	for versionID, stages := range output.VersionIdsToStages {
+		if versionID == version {
+			targetVersionID = versionID
+		}
+		for _, stage := range aws.StringValueSlice(stages) {
+			if stage == currentStage {
+				currentVersionID = versionID
+			}
+			if stage == version && targetVersionID == "" {
+				targetVersionID = versionID
+			}
+		}
+	}
+	if targetVersionID == "" {
+		return secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, errors.New("version not found: "+version))
+	}
+	if targetVersionID == currentVersionID {
+		return nil
+	}
+
+	// Move the current staging label.
+	input := &secretsmanager.UpdateSecretVersionStageInput{
+		MoveToVersionId: &targetVersionID,
+		SecretId:        &path,
+		VersionStage:    aws.String(currentStage),
+	}
+	if currentVersionID != "" {
+		input.RemoveFromVersionId = &currentVersionID
+	}
+	_, err = a.secretsManager.UpdateSecretVersionStageWithContext(ctx, input)
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Rolled back secret: "+path)
+	} else {
+		logger.Info(ctx, "Rolled back secret.")
+	}
+
+	return nil
+}
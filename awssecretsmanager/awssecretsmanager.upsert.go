@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"os"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
 	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 )
 
 // UpsertSecret creates or updates a secret.
@@ -34,12 +34,14 @@ func (a *AWSSecretsManager) UpsertSecret(ctx context.Context, path string, data
 		SecretBinary: dataBytes,
 		SecretId:     &path,
 	})
+	err = wrapError(path, err)
 	// If the secret does not exist, create it.
-	if err != nil && strings.HasPrefix(err.Error(), "ResourceNotFoundException") {
+	if errors.Is(err, secretprovidertype.ErrNotFound) {
 		_, err = a.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
 			Name:         &path,
 			SecretBinary: dataBytes,
 		})
+		err = wrapError(path, err)
 	}
 	if err != nil {
 		return err
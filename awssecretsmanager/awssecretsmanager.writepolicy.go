@@ -0,0 +1,14 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// WritePolicy creates or replaces a policy.
+// Policies are not supported by AWS Secrets Manager, which uses IAM for access control.
+func (a *AWSSecretsManager) WritePolicy(ctx context.Context, policy *secretprovidertype.Policy) error {
+	return secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("policies are not supported by AWS Secrets Manager"))
+}
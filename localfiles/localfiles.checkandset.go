@@ -0,0 +1,59 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// CheckAndSetSecret creates or updates a secret only if its current version matches the expected version.
+// An empty expected version requires that the secret does not exist. A mismatch returns ErrConflict.
+func (l *LocalFiles) CheckAndSetSecret(ctx context.Context, path string, data map[string]interface{}, expectedVersion string) (version string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	if path == "" {
+		return "", errors.New("path is required")
+	}
+	if strings.Contains(path, "..") {
+		return "", invalidPathError(path)
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Lock secret.
+	if !strings.HasSuffix(path, ".secret") {
+		path += ".secret"
+	}
+	unlock, err := lockSecret(ctx, l.secretURI(path), path)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	// Compare versions.
+	currentVersion, err := l.currentVersion(path)
+	if err != nil && !errors.Is(err, secretprovidertype.ErrNotFound) {
+		return "", err
+	}
+	if currentVersion != expectedVersion {
+		return "", secretprovidertype.NewError(secretprovidertype.ErrConflict, path, errors.New("expected version "+expectedVersion+" but found "+currentVersion))
+	}
+
+	// Write secret.
+	version, err = l.writeSecret(path, data)
+	if err != nil {
+		return "", err
+	}
+
+	// Log.
+	logger.Info(ctx, "Upserted secret.")
+
+	return version, nil
+}
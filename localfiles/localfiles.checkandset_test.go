@@ -0,0 +1,55 @@
+package localfiles
+
+import (
+	"sync"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckAndSetSecret tests CheckAndSetSecret().
+func TestCheckAndSetSecret(t *testing.T) {
+	secretPath := "secret/checkandsetsecret"
+
+	// Create a secret that must not exist.
+	version, err := localFilesClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "one"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", version)
+	_, err = localFilesClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "one"}, "")
+	assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+
+	// Update from a stale version.
+	_, err = localFilesClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "two"}, "5")
+	assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+
+	// Race to update from the same version.
+	var (
+		successes int
+		lock      sync.Mutex
+		wg        sync.WaitGroup
+	)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := localFilesClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "two"}, version)
+			if err == nil {
+				lock.Lock()
+				successes++
+				lock.Unlock()
+			} else {
+				assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, successes)
+
+	// Confirm the current version.
+	metadata, err := localFilesClient.DescribeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, metadata) {
+		assert.Equal(t, "2", metadata.Version)
+	}
+}
@@ -0,0 +1,7 @@
+package localfiles
+
+// Close releases resources held by the secret provider.
+// Local files hold no background resources, so Close does nothing.
+func (l *LocalFiles) Close() error {
+	return nil
+}
@@ -0,0 +1,59 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilio "github.com/bertjohnson/util/io"
+)
+
+// CreateEncryptionKey creates a named key stored alongside the secrets.
+// An empty key type creates an aes256-gcm96 key. Creating a key that already exists has no effect.
+func (l *LocalFiles) CreateEncryptionKey(ctx context.Context, name string, keyType string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	err := secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return err
+	}
+	if keyType == "" {
+		keyType = secretprovidertype.KeyTypeAES256GCM96
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Lock key.
+	uri := l.keyURI(name)
+	unlock, err := lockSecret(ctx, uri, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if utilio.FileExists(uri) {
+		return nil
+	}
+
+	// Create key.
+	keyVersion, err := newEncryptionKeyVersion(keyType)
+	if err != nil {
+		return err
+	}
+	err = writeEncryptionKey(uri, name, &encryptionKey{
+		Type:     keyType,
+		Versions: []*encryptionKeyVersion{keyVersion},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Log.
+	logger.Info(ctx, "Created encryption key.")
+
+	return nil
+}
@@ -2,14 +2,13 @@ package localfiles
 
 import (
 	"context"
+	"errors"
 
-	"github.com/bertjohnson/logger"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 )
 
 // CreateToken creates a token.
-func (l *LocalFiles) CreateToken(ctx context.Context, id string, displayName string, numUses int, policies []string) (token string, err error) {
-	// Log.
-	logger.Info(ctx, "Created token.")
-
-	return "", nil
+// Tokens are not supported by local files.
+func (l *LocalFiles) CreateToken(ctx context.Context, options *secretprovidertype.TokenOptions) (token *secretprovidertype.Token, err error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by local files"))
 }
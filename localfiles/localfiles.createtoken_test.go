@@ -0,0 +1,14 @@
+package localfiles
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateToken tests CreateToken.
+func TestCreateToken(t *testing.T) {
+	_, err := localFilesClient.CreateToken(ctx, &secretprovidertype.TokenOptions{})
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotSupported)
+}
@@ -0,0 +1,45 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Decrypt decrypts ciphertext with a named key.
+func (l *LocalFiles) Decrypt(ctx context.Context, name string, ciphertext string) (plaintext []byte, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return nil, err
+	}
+	if ciphertext == "" {
+		return nil, errors.New("ciphertext is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read key.
+	key, err := readEncryptionKey(l.keyURI(name), name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decrypt.
+	plaintext, err = key.decrypt(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Decrypted data.")
+
+	return plaintext, nil
+}
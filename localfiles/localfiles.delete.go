@@ -22,18 +22,35 @@ func (l *LocalFiles) DeleteSecret(ctx context.Context, path string) error {
 		return errors.New("path is required")
 	}
 	if strings.Contains(path, "..") {
-		return errors.New("path contains fobidden sequence (..): " + path)
+		return invalidPathError(path)
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
 
-	// Delete item.
+	// Delete item, holding the secret's lock so that deletes cannot interleave with other writes.
 	originalURI := l.basePath + pathSeparator + path + ".secret"
+	unlock, err := lockSecret(ctx, l.secretURI(path+".secret"), path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	if utilio.FileExists(originalURI) {
 		err := os.Remove(originalURI)
 		if err != nil {
-			return err
+			return wrapError(path, err)
+		}
+	}
+	if utilio.FileExists(metadataURI(originalURI)) {
+		err := os.Remove(metadataURI(originalURI))
+		if err != nil {
+			return wrapError(path, err)
+		}
+	}
+	if utilio.DirectoryExists(l.historyURI(path)) {
+		err := os.RemoveAll(l.historyURI(path))
+		if err != nil {
+			return wrapError(path, err)
 		}
 	}
 
@@ -0,0 +1,41 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilio "github.com/bertjohnson/util/io"
+)
+
+// DeletePolicy deletes a policy.
+func (l *LocalFiles) DeletePolicy(ctx context.Context, name string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	err := secretprovidertype.ValidatePolicyName(name)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Delete policy.
+	uri := l.policyURI(name)
+	if utilio.FileExists(uri) {
+		err = os.Remove(uri)
+		if err != nil {
+			return wrapError(name, err)
+		}
+	}
+
+	// Log.
+	logger.Info(ctx, "Deleted policy.")
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilio "github.com/bertjohnson/util/io"
+)
+
+// DescribeSecret returns a secret's metadata without reading its data.
+func (l *LocalFiles) DescribeSecret(ctx context.Context, path string) (metadata *secretprovidertype.SecretMetadata, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	if strings.Contains(path, "..") {
+		return nil, invalidPathError(path)
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read metadata.
+	if !strings.HasSuffix(path, ".secret") {
+		path += ".secret"
+	}
+	metadata, err = readMetadata(l.basePath+pathSeparator+utilio.NormalizePathSeparators(path), path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Described secret.")
+
+	return metadata, nil
+}
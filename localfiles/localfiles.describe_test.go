@@ -0,0 +1,40 @@
+package localfiles
+
+import (
+	"io/ioutil"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDescribeSecret tests DescribeSecret().
+func TestDescribeSecret(t *testing.T) {
+	secretPath := "secret/describesecret"
+	err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+	assert.NoError(t, err)
+
+	// Describe secret.
+	metadata, err := localFilesClient.DescribeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, metadata) {
+		assert.NotNil(t, metadata.Created)
+		assert.NotNil(t, metadata.Updated)
+	}
+
+	// Read sidecar metadata.
+	err = ioutil.WriteFile(localFilesClient.basePath+pathSeparator+"secret"+pathSeparator+"describesecret.metadata", []byte(`{"description":"Test secret","owner":"tests","tags":{"env":"test"}}`), 0644)
+	assert.NoError(t, err)
+	readSecret, err := localFilesClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, readSecret) && assert.NotNil(t, readSecret.Metadata) {
+		assert.Equal(t, "Test secret", readSecret.Metadata.Description)
+		assert.Equal(t, "tests", readSecret.Metadata.Owner)
+		assert.Equal(t, map[string]string{"env": "test"}, readSecret.Metadata.Tags)
+		assert.NotNil(t, readSecret.Metadata.Updated)
+	}
+
+	// Describe nonexistent secret.
+	_, err = localFilesClient.DescribeSecret(ctx, "secret/nonexistent")
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
@@ -0,0 +1,80 @@
+package localfiles
+
+import (
+	"strings"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEncrypt tests CreateEncryptionKey(), Encrypt(), Decrypt(), RotateEncryptionKey() and Rewrap().
+func TestEncrypt(t *testing.T) {
+	err := localFilesClient.CreateEncryptionKey(ctx, "testkey", "")
+	assert.NoError(t, err)
+
+	// Encrypt and decrypt.
+	ciphertext, err := localFilesClient.Encrypt(ctx, "testkey", []byte("plaintext"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "local:v1:"))
+	plaintext, err := localFilesClient.Decrypt(ctx, "testkey", ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "plaintext", string(plaintext))
+
+	// Rotate and rewrap.
+	err = localFilesClient.RotateEncryptionKey(ctx, "testkey")
+	assert.NoError(t, err)
+	rewrapped, err := localFilesClient.Rewrap(ctx, "testkey", ciphertext)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rewrapped, "local:v2:"))
+	plaintext, err = localFilesClient.Decrypt(ctx, "testkey", ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "plaintext", string(plaintext))
+	plaintext, err = localFilesClient.Decrypt(ctx, "testkey", rewrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, "plaintext", string(plaintext))
+
+	// Reject tampered ciphertext and missing keys.
+	_, err = localFilesClient.Decrypt(ctx, "testkey", "local:v2:"+strings.Repeat("A", 40))
+	assert.Error(t, err)
+	_, err = localFilesClient.Encrypt(ctx, "nonexistentkey", []byte("plaintext"))
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+
+	// Keys are not listed as secrets.
+	err = localFilesClient.WalkSecretPaths(ctx, nil, func(path string, folder bool, err error) error {
+		assert.NotContains(t, path, keyDirectoryName)
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+// TestSign tests Sign() and Verify().
+func TestSign(t *testing.T) {
+	for _, keyType := range []string{secretprovidertype.KeyTypeECDSAP256, secretprovidertype.KeyTypeED25519} {
+		name := "testsigningkey-" + keyType
+		err := localFilesClient.CreateEncryptionKey(ctx, name, keyType)
+		assert.NoError(t, err)
+
+		// Sign and verify.
+		signature, err := localFilesClient.Sign(ctx, name, []byte("input"))
+		assert.NoError(t, err)
+		valid, err := localFilesClient.Verify(ctx, name, []byte("input"), signature)
+		assert.NoError(t, err)
+		assert.True(t, valid, keyType)
+		valid, err = localFilesClient.Verify(ctx, name, []byte("other input"), signature)
+		assert.NoError(t, err)
+		assert.False(t, valid, keyType)
+
+		// Signatures remain valid after rotation.
+		err = localFilesClient.RotateEncryptionKey(ctx, name)
+		assert.NoError(t, err)
+		valid, err = localFilesClient.Verify(ctx, name, []byte("input"), signature)
+		assert.NoError(t, err)
+		assert.True(t, valid, keyType)
+
+		// Signing keys cannot encrypt.
+		_, err = localFilesClient.Encrypt(ctx, name, []byte("plaintext"))
+		assert.ErrorIs(t, err, secretprovidertype.ErrNotSupported)
+	}
+}
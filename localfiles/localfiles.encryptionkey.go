@@ -0,0 +1,259 @@
+package localfiles
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	json "github.com/json-iterator/go"
+)
+
+const (
+	// Name of the directory holding encryption keys.
+	keyDirectoryName = ".keys"
+
+	// Extension of encryption key files.
+	keyExtension = ".key"
+
+	// Prefix of ciphertexts and signatures produced by local keys.
+	localCiphertextPrefix = "local:v"
+)
+
+// LocalFiles implements encryption using keys stored alongside the secrets.
+var _ secretprovidertype.IEncryptionProvider = (*LocalFiles)(nil)
+
+// encryptionKey is a named key, with every version retained to decrypt and verify older data.
+type encryptionKey struct {
+	Type     string                  `json:"type"`     // Type of the key (e.g., aes256-gcm96).
+	Versions []*encryptionKeyVersion `json:"versions"` // Versions of the key, oldest first.
+}
+
+// encryptionKeyVersion is a version of an encryption key.
+type encryptionKeyVersion struct {
+	Created time.Time `json:"created"` // Time the version was created.
+	Key     []byte    `json:"key"`     // Key material: an AES key, an Ed25519 seed or a DER-encoded ECDSA private key.
+}
+
+// keyURI returns the URI of an encryption key file.
+func (l *LocalFiles) keyURI(name string) string {
+	return l.basePath + pathSeparator + keyDirectoryName + pathSeparator + name + keyExtension
+}
+
+// readEncryptionKey reads and deserializes an encryption key file.
+func readEncryptionKey(uri string, name string) (*encryptionKey, error) {
+	keyBytes, err := ioutil.ReadFile(uri) // #nosec G304
+	if err != nil {
+		return nil, wrapError(name, err)
+	}
+	key := &encryptionKey{}
+	err = json.Unmarshal(keyBytes, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(key.Versions) == 0 {
+		return nil, errors.New("encryption key has no versions: " + name)
+	}
+
+	return key, nil
+}
+
+// writeEncryptionKey serializes and writes an encryption key file, readable only by its owner.
+func writeEncryptionKey(uri string, name string, key *encryptionKey) error {
+	keyBytes, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(uri, keyBytes, 0600)
+	if err != nil {
+		return wrapError(name, err)
+	}
+
+	return nil
+}
+
+// newEncryptionKeyVersion generates a new version of a key of the specified type.
+func newEncryptionKeyVersion(keyType string) (*encryptionKeyVersion, error) {
+	keyVersion := &encryptionKeyVersion{
+		Created: time.Now().UTC(),
+	}
+	switch keyType {
+	case secretprovidertype.KeyTypeAES256GCM96:
+		keyVersion.Key = make([]byte, 32)
+		_, err := io.ReadFull(rand.Reader, keyVersion.Key)
+		if err != nil {
+			return nil, err
+		}
+	case secretprovidertype.KeyTypeECDSAP256:
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		keyVersion.Key, err = x509.MarshalECPrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+	case secretprovidertype.KeyTypeED25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		keyVersion.Key = privateKey.Seed()
+	default:
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("key type is not supported: "+keyType))
+	}
+
+	return keyVersion, nil
+}
+
+// version returns a version of the key, numbered from 1.
+func (k *encryptionKey) version(version int) (*encryptionKeyVersion, error) {
+	if version < 1 || version > len(k.Versions) {
+		return nil, errors.New("key version does not exist: " + strconv.Itoa(version))
+	}
+
+	return k.Versions[version-1], nil
+}
+
+// encrypt encrypts plaintext with the latest version of the key.
+func (k *encryptionKey) encrypt(plaintext []byte) (string, error) {
+	if k.Type != secretprovidertype.KeyTypeAES256GCM96 {
+		return "", secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("key type does not support encryption: "+k.Type))
+	}
+	version := len(k.Versions)
+	aead, err := newAEAD(k.Versions[version-1].Key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return "", err
+	}
+
+	return formatVersioned(version, aead.Seal(nonce, nonce, plaintext, nil)), nil
+}
+
+// decrypt decrypts ciphertext with the version of the key that encrypted it.
+func (k *encryptionKey) decrypt(ciphertext string) ([]byte, error) {
+	if k.Type != secretprovidertype.KeyTypeAES256GCM96 {
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("key type does not support decryption: "+k.Type))
+	}
+	version, sealed, err := parseVersioned(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	keyVersion, err := k.version(version)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(keyVersion.Key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+}
+
+// sign signs input with the latest version of the key.
+func (k *encryptionKey) sign(input []byte) (string, error) {
+	version := len(k.Versions)
+	keyVersion := k.Versions[version-1]
+	switch k.Type {
+	case secretprovidertype.KeyTypeECDSAP256:
+		privateKey, err := x509.ParseECPrivateKey(keyVersion.Key)
+		if err != nil {
+			return "", err
+		}
+		digest := sha256.Sum256(input)
+		signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+		if err != nil {
+			return "", err
+		}
+
+		return formatVersioned(version, signature), nil
+	case secretprovidertype.KeyTypeED25519:
+		return formatVersioned(version, ed25519.Sign(ed25519.NewKeyFromSeed(keyVersion.Key), input)), nil
+	default:
+		return "", secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("key type does not support signing: "+k.Type))
+	}
+}
+
+// verify verifies the signature of input with the version of the key that signed it.
+func (k *encryptionKey) verify(input []byte, signature string) (bool, error) {
+	version, signatureBytes, err := parseVersioned(signature)
+	if err != nil {
+		return false, err
+	}
+	keyVersion, err := k.version(version)
+	if err != nil {
+		return false, err
+	}
+	switch k.Type {
+	case secretprovidertype.KeyTypeECDSAP256:
+		privateKey, err := x509.ParseECPrivateKey(keyVersion.Key)
+		if err != nil {
+			return false, err
+		}
+		digest := sha256.Sum256(input)
+
+		return ecdsa.VerifyASN1(&privateKey.PublicKey, digest[:], signatureBytes), nil
+	case secretprovidertype.KeyTypeED25519:
+		publicKey, _ := ed25519.NewKeyFromSeed(keyVersion.Key).Public().(ed25519.PublicKey) // nolint
+
+		return ed25519.Verify(publicKey, input, signatureBytes), nil
+	default:
+		return false, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("key type does not support signing: "+k.Type))
+	}
+}
+
+// newAEAD returns an AES-GCM cipher for an AES key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// formatVersioned formats a ciphertext or signature, recording the key version that produced it.
+func formatVersioned(version int, data []byte) string {
+	return localCiphertextPrefix + strconv.Itoa(version) + ":" + base64.StdEncoding.EncodeToString(data)
+}
+
+// parseVersioned parses a ciphertext or signature produced by formatVersioned.
+func parseVersioned(value string) (version int, data []byte, err error) {
+	if !strings.HasPrefix(value, localCiphertextPrefix) {
+		return 0, nil, errors.New("value was not produced by a local key")
+	}
+	separator := strings.Index(value[len(localCiphertextPrefix):], ":")
+	if separator < 0 {
+		return 0, nil, errors.New("value does not specify a key version")
+	}
+	version, err = strconv.Atoi(value[len(localCiphertextPrefix) : len(localCiphertextPrefix)+separator])
+	if err != nil {
+		return 0, nil, errors.New("value does not specify a valid key version")
+	}
+	data, err = base64.StdEncoding.DecodeString(value[len(localCiphertextPrefix)+separator+1:])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return version, data, nil
+}
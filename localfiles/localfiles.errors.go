@@ -0,0 +1,30 @@
+package localfiles
+
+import (
+	"errors"
+	"io/fs"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// wrapError classifies a file system error using the shared secret provider errors.
+// Errors that cannot be classified are returned unchanged.
+func wrapError(path string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, fs.ErrNotExist):
+		return secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, err)
+	case errors.Is(err, fs.ErrExist):
+		return secretprovidertype.NewError(secretprovidertype.ErrAlreadyExists, path, err)
+	case errors.Is(err, fs.ErrPermission):
+		return secretprovidertype.NewError(secretprovidertype.ErrPermissionDenied, path, err)
+	default:
+		return err
+	}
+}
+
+// invalidPathError returns an error for a path containing a forbidden sequence.
+func invalidPathError(path string) error {
+	return secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, path, errors.New("path contains fobidden sequence (..): "+path))
+}
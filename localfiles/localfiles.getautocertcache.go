@@ -27,7 +27,7 @@ func (a AutoCertCache) Get(ctx context.Context, name string) ([]byte, error) {
 		return nil, errors.New("context is required")
 	}
 	if strings.Contains(name, "/") {
-		return nil, errors.New("name cannot traverse directories")
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	name = filepath.Join(string(a), name)
@@ -59,7 +59,7 @@ func (a AutoCertCache) Put(ctx context.Context, name string, data []byte) error
 		return errors.New("context is required")
 	}
 	if strings.Contains(name, "/") {
-		return errors.New("name cannot traverse directories")
+		return secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	if err := os.MkdirAll(string(a), 0700); err != nil {
@@ -24,6 +24,18 @@ var (
 	pathSeparator = string(os.PathSeparator)
 )
 
+// init registers the LocalFiles secret provider type.
+func init() {
+	secretprovidertype.Register("localfiles", func(ctx context.Context, secretProvider *secretprovidertype.SecretProvider) (secretprovidertype.ISecretProvider, error) {
+		localFilesClient, err := New(ctx, secretProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return localFilesClient, nil
+	})
+}
+
 // New creates a matching secret store implementation.
 func New(ctx context.Context, secretStore *secretprovidertype.SecretProvider) (*LocalFiles, error) {
 	// Validate parameters.
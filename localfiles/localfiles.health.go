@@ -0,0 +1,35 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Health reports whether the secret directory can be written.
+func (l *LocalFiles) Health(ctx context.Context) (health *secretprovidertype.Health, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+
+	// Write a temporary file.
+	health = &secretprovidertype.Health{
+		Checked: time.Now(),
+	}
+	file, err := ioutil.TempFile(l.basePath, ".health")
+	if err != nil {
+		health.Error = wrapError("", err).Error()
+
+		return health, nil
+	}
+	file.Close()           // nolint
+	os.Remove(file.Name()) // nolint
+	health.Healthy = true
+
+	return health, nil
+}
@@ -0,0 +1,19 @@
+package localfiles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHealth tests Health().
+func TestHealth(t *testing.T) {
+	health, err := localFilesClient.Health(ctx)
+	assert.NoError(t, err)
+	if assert.NotNil(t, health) {
+		assert.True(t, health.Healthy)
+		assert.False(t, health.Sealed)
+		assert.Empty(t, health.Error)
+		assert.False(t, health.Checked.IsZero())
+	}
+}
@@ -0,0 +1,116 @@
+package localfiles
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilio "github.com/bertjohnson/util/io"
+	json "github.com/json-iterator/go"
+)
+
+const (
+	// Name of the directory holding previous versions of secrets.
+	historyDirectoryName = ".versions"
+)
+
+// secretURI returns the URI of a secret file.
+func (l *LocalFiles) secretURI(path string) string {
+	return l.basePath + pathSeparator + utilio.NormalizePathSeparators(path)
+}
+
+// historyURI returns the URI of the directory holding the versions of a secret.
+func (l *LocalFiles) historyURI(path string) string {
+	return l.basePath + pathSeparator + historyDirectoryName + pathSeparator + utilio.NormalizePathSeparators(strings.TrimSuffix(path, ".secret"))
+}
+
+// versionURI returns the URI of a version of a secret.
+func (l *LocalFiles) versionURI(path string, version string) string {
+	return l.historyURI(path) + pathSeparator + version + ".secret"
+}
+
+// writeSecret writes a new version of a secret, recording it in the secret's history.
+func (l *LocalFiles) writeSecret(path string, data map[string]interface{}) (version string, err error) {
+	// Ensure directories exist.
+	uri := l.secretURI(path)
+	lastSlash := strings.LastIndex(uri, pathSeparator)
+	err = utilio.EnsureDirectory(uri[0:lastSlash])
+	if err != nil {
+		return "", wrapError(path, err)
+	}
+	err = utilio.EnsureDirectory(l.historyURI(path))
+	if err != nil {
+		return "", wrapError(path, err)
+	}
+
+	// Serialize data.
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	// Determine the next version.
+	metadata, err := readSidecar(uri, path)
+	if err != nil {
+		return "", err
+	}
+	currentVersion, _ := strconv.Atoi(metadata.Version) // nolint
+	if currentVersion == 0 && utilio.FileExists(uri) {
+		// Preserve secrets written before versioning as the first version.
+		existingBytes, err := ioutil.ReadFile(uri) // #nosec G304
+		if err != nil {
+			return "", wrapError(path, err)
+		}
+		currentVersion = 1
+		err = ioutil.WriteFile(l.versionURI(path, "1"), existingBytes, 0644)
+		if err != nil {
+			return "", wrapError(path, err)
+		}
+	}
+	version = strconv.Itoa(currentVersion + 1)
+
+	// Write secret to history and file.
+	err = ioutil.WriteFile(l.versionURI(path, version), dataBytes, 0644)
+	if err != nil {
+		return "", wrapError(path, err)
+	}
+	err = ioutil.WriteFile(uri, dataBytes, 0644)
+	if err != nil {
+		return "", wrapError(path, err)
+	}
+
+	// Update metadata.
+	now := time.Now().UTC()
+	if metadata.Created == nil {
+		metadata.Created = &now
+	}
+	metadata.Updated = &now
+	metadata.Version = version
+	err = writeSidecar(uri, path, metadata)
+	if err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// currentVersion returns the current version of a secret.
+// Secrets written before versioning are reported as the first version.
+func (l *LocalFiles) currentVersion(path string) (string, error) {
+	uri := l.secretURI(path)
+	if !utilio.FileExists(uri) {
+		return "", secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, os.ErrNotExist)
+	}
+	metadata, err := readSidecar(uri, path)
+	if err != nil {
+		return "", err
+	}
+	if metadata.Version == "" {
+		return "1", nil
+	}
+
+	return metadata.Version, nil
+}
@@ -5,55 +5,110 @@ import (
 	"context"
 	"errors"
 	"io/ioutil"
+	"os"
 	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	utilio "github.com/bertjohnson/util/io"
 )
 
-// ListSecrets lists secret paths.
-func (l *LocalFiles) ListSecrets(ctx context.Context, pathChannel chan string, errorChannel chan error) {
-	// Validate parameters.
-	if ctx == nil {
-		errorChannel <- errors.New("context is required")
+// Directories at the root of the base path that do not hold secrets.
+var reservedDirectoryNames = map[string]bool{
+	historyDirectoryName: true,
+	keyDirectoryName:     true,
+	policyDirectoryName:  true,
+}
 
-		close(pathChannel)
-		close(errorChannel)
+// ListSecrets lists secret paths. Folder entries are only reported by WalkSecretPaths.
+func (l *LocalFiles) ListSecrets(ctx context.Context, options *secretprovidertype.ListOptions, pathChannel chan string, errorChannel chan error) {
+	secretprovidertype.StreamSecretPaths(ctx, options, l.WalkSecretPaths, pathChannel, errorChannel)
+}
 
-		return
+// WalkSecretPaths calls fn for each secret path.
+func (l *LocalFiles) WalkSecretPaths(ctx context.Context, options *secretprovidertype.ListOptions, fn secretprovidertype.PathWalkFunc) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if fn == nil {
+		return errors.New("walk function is required")
+	}
+	folder := options.Folder()
+	if strings.Contains(folder, "..") {
+		return invalidPathError(folder)
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
 
-	// Read directory.
-	uri := l.basePath
-	fis, err := ioutil.ReadDir(uri)
+	// Walk directories.
+	err := l.walkDirectory(ctx, options, folder, fn)
+	if err == secretprovidertype.StopWalk {
+		return nil
+	}
 	if err != nil {
-		errorChannel <- err
+		return err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Listed secrets.")
 
-		close(pathChannel)
-		close(errorChannel)
+	return nil
+}
 
-		return
+// walkDirectory calls fn for each secret in a folder, descending into subfolders if recursive and otherwise reporting them as folders.
+func (l *LocalFiles) walkDirectory(ctx context.Context, options *secretprovidertype.ListOptions, folder string, fn secretprovidertype.PathWalkFunc) error {
+	// Read directory.
+	fis, err := ioutil.ReadDir(l.basePath + pathSeparator + utilio.NormalizePathSeparators(folder))
+	if err != nil {
+		if folder != "" && os.IsNotExist(err) {
+			return nil
+		}
+
+		return wrapError(folder, err)
 	}
 
 	// Loop through directory.
 	for _, fi := range fis {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
 		fileName := fi.Name()
-		if !fi.IsDir() {
-			lastDot := strings.LastIndex(fileName, ".")
-			fileExtension := strings.ToLower(fileName[lastDot+1:])
-			switch fileExtension {
-			case "secret":
-				pathChannel <- fileName[0 : len(fileName)-7]
+		switch {
+		case fi.IsDir():
+			if folder == "" && reservedDirectoryNames[fileName] {
+				continue
 			}
+			if options.IsRecursive() {
+				err = l.walkDirectory(ctx, options, folder+fileName+"/", fn)
+			} else {
+				err = fn(folder+fileName+"/", true, nil)
+			}
+		case strings.HasSuffix(strings.ToLower(fileName), ".secret"):
+			path := folder + fileName[0:len(fileName)-7]
+			if options != nil && len(options.Tags) > 0 {
+				metadata, err := readSidecar(l.secretURI(path+".secret"), path)
+				if err != nil {
+					err = fn(path, false, err)
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+				if !options.MatchesTags(metadata.Tags) {
+					continue
+				}
+			}
+			err = fn(path, false, nil)
+		}
+		if err != nil {
+			return err
 		}
 	}
 
-	// Log.
-	logger.Verbose(ctx, "Listed secrets.")
-
-	close(pathChannel)
-	close(errorChannel)
+	return nil
 }
@@ -0,0 +1,149 @@
+package localfiles
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWalkSecretPaths tests WalkSecretPaths() and ListSecrets().
+func TestWalkSecretPaths(t *testing.T) {
+	for _, secretPath := range []string{"listsecret1", "listsecret2"} {
+		err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+		assert.NoError(t, err)
+	}
+
+	// Walk secret paths.
+	var paths []string
+	err := localFilesClient.WalkSecretPaths(ctx, nil, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		paths = append(paths, path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Subset(t, paths, []string{"listsecret1", "listsecret2"})
+
+	// Stop walking early.
+	paths = nil
+	err = localFilesClient.WalkSecretPaths(ctx, nil, func(path string, folder bool, err error) error {
+		paths = append(paths, path)
+
+		return secretprovidertype.StopWalk
+	})
+	assert.NoError(t, err)
+	assert.Len(t, paths, 1)
+
+	// List secrets through channels.
+	pathChannel := make(chan string)
+	errorChannel := make(chan error)
+	go localFilesClient.ListSecrets(ctx, nil, pathChannel, errorChannel)
+	paths = nil
+	for path := range pathChannel {
+		paths = append(paths, path)
+	}
+	for err = range errorChannel {
+		assert.NoError(t, err)
+	}
+	assert.Subset(t, paths, []string{"listsecret1", "listsecret2"})
+
+	// Abandon the stream by canceling the context.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	pathChannel = make(chan string)
+	errorChannel = make(chan error)
+	go localFilesClient.ListSecrets(cancelCtx, nil, pathChannel, errorChannel)
+	<-pathChannel
+	cancel()
+	for range pathChannel {
+	}
+	for range errorChannel {
+	}
+}
+
+// TestWalkSecretPathsOptions tests WalkSecretPaths() with prefix and recursion options.
+func TestWalkSecretPathsOptions(t *testing.T) {
+	for _, secretPath := range []string{"listtree/top", "listtree/app/one", "listtree/app/database/two"} {
+		err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+		assert.NoError(t, err)
+	}
+
+	// List a folder recursively.
+	var paths []string
+	err := localFilesClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{Prefix: "listtree"}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		assert.False(t, folder)
+		paths = append(paths, path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"listtree/top", "listtree/app/one", "listtree/app/database/two"}, paths)
+
+	// List a folder non-recursively.
+	var folders []string
+	paths = nil
+	err = localFilesClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{NonRecursive: true, Prefix: "listtree/app/"}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		if folder {
+			folders = append(folders, path)
+		} else {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"listtree/app/database/"}, folders)
+	assert.Equal(t, []string{"listtree/app/one"}, paths)
+
+	// List a folder non-recursively through channels, which omit folders.
+	pathChannel := make(chan string)
+	errorChannel := make(chan error)
+	go localFilesClient.ListSecrets(ctx, &secretprovidertype.ListOptions{NonRecursive: true, Prefix: "listtree/app/"}, pathChannel, errorChannel)
+	paths = nil
+	for path := range pathChannel {
+		paths = append(paths, path)
+	}
+	for err = range errorChannel {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"listtree/app/one"}, paths)
+
+	// List a folder that does not exist.
+	err = localFilesClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{Prefix: "listtree/missing"}, func(path string, folder bool, err error) error {
+		t.Errorf("unexpected path: %s", path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Reject paths outside the base directory.
+	err = localFilesClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{Prefix: "../"}, func(path string, folder bool, err error) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, secretprovidertype.ErrInvalidPath)
+}
+
+// TestWalkSecretPathsTags tests WalkSecretPaths() with tag filters.
+func TestWalkSecretPathsTags(t *testing.T) {
+	for _, secretPath := range []string{"listtags/tagged", "listtags/untagged"} {
+		err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+		assert.NoError(t, err)
+	}
+	err := ioutil.WriteFile(localFilesClient.basePath+pathSeparator+"listtags"+pathSeparator+"tagged.metadata", []byte(`{"tags":{"env":"test"}}`), 0644)
+	assert.NoError(t, err)
+
+	// List tagged secrets.
+	var paths []string
+	err = localFilesClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{Prefix: "listtags", Tags: map[string]string{"env": "test"}}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		paths = append(paths, path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"listtags/tagged"}, paths)
+}
@@ -0,0 +1,42 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// ListPolicies lists the names of policies.
+func (l *LocalFiles) ListPolicies(ctx context.Context) (names []string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// List policy files.
+	fis, err := ioutil.ReadDir(l.policyDirectoryURI())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, wrapError("", err)
+	}
+	names = []string{}
+	for _, fi := range fis {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), policyExtension) {
+			names = append(names, strings.TrimSuffix(fi.Name(), policyExtension))
+		}
+	}
+	sort.Strings(names)
+
+	// Log.
+	logger.Verbose(ctx, "Listed policies.")
+
+	return names, nil
+}
@@ -0,0 +1,82 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ListSecretVersions lists the versions of a secret, oldest first.
+func (l *LocalFiles) ListSecretVersions(ctx context.Context, path string) (versions []*secretprovidertype.SecretVersion, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	if strings.Contains(path, "..") {
+		return nil, invalidPathError(path)
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Determine the current version.
+	if !strings.HasSuffix(path, ".secret") {
+		path += ".secret"
+	}
+	currentVersion, err := l.currentVersion(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read history directory.
+	fis, err := ioutil.ReadDir(l.historyURI(path))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, wrapError(path, err)
+	}
+	for _, fi := range fis {
+		fileName := fi.Name()
+		if fi.IsDir() || !strings.HasSuffix(fileName, ".secret") {
+			continue
+		}
+		version := fileName[0 : len(fileName)-7]
+		if _, err = strconv.Atoi(version); err != nil {
+			continue
+		}
+		created := fi.ModTime().UTC()
+		versions = append(versions, &secretprovidertype.SecretVersion{
+			Created: &created,
+			Current: version == currentVersion,
+			Version: version,
+		})
+	}
+
+	// Secrets written before versioning only have their current version.
+	if len(versions) == 0 {
+		versions = append(versions, &secretprovidertype.SecretVersion{
+			Current: true,
+			Version: currentVersion,
+		})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		iVersion, _ := strconv.Atoi(versions[i].Version) // nolint
+		jVersion, _ := strconv.Atoi(versions[j].Version) // nolint
+
+		return iVersion < jVersion
+	})
+
+	// Log.
+	logger.Verbose(ctx, "Listed secret versions.")
+
+	return versions, nil
+}
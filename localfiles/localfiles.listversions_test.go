@@ -0,0 +1,50 @@
+package localfiles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSecretVersions tests ListSecretVersions(), ReadSecretVersion() and RollbackSecret().
+func TestSecretVersions(t *testing.T) {
+	secretPath := "secret/versionsecret"
+	for _, value := range []string{"one", "two", "three"} {
+		err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"value": value})
+		assert.NoError(t, err)
+	}
+
+	// List versions.
+	versions, err := localFilesClient.ListSecretVersions(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 3) {
+		assert.Equal(t, "1", versions[0].Version)
+		assert.False(t, versions[0].Current)
+		assert.Equal(t, "3", versions[2].Version)
+		assert.True(t, versions[2].Current)
+	}
+
+	// Read a previous version.
+	secret, err := localFilesClient.ReadSecretVersion(ctx, secretPath, "1")
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, "one", secret.Data["value"])
+		assert.Equal(t, "1", secret.Metadata.Version)
+	}
+
+	// Roll back to a previous version.
+	err = localFilesClient.RollbackSecret(ctx, secretPath, "1")
+	assert.NoError(t, err)
+	secret, err = localFilesClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, "one", secret.Data["value"])
+		assert.Equal(t, "4", secret.Metadata.Version)
+	}
+
+	// Deleting a secret removes its history.
+	err = localFilesClient.DeleteSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	_, err = localFilesClient.ListSecretVersions(ctx, secretPath)
+	assert.Error(t, err)
+}
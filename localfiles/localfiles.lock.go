@@ -0,0 +1,60 @@
+package localfiles
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	utilio "github.com/bertjohnson/util/io"
+)
+
+const (
+	// Interval between attempts to acquire a lock.
+	lockRetryInterval = 10 * time.Millisecond
+)
+
+// lockSecret acquires an exclusive lock on a secret file, shared with other processes using the same directory.
+// The lock is an OS advisory lock on a lock file beside the secret, so it is released if its holder exits.
+// Lock files are left in place, since removing them would let two holders lock different files.
+// The returned function releases the lock.
+func lockSecret(ctx context.Context, uri string, path string) (unlock func(), err error) {
+	// Ensure the directory exists.
+	lastSlash := strings.LastIndex(uri, pathSeparator)
+	err = utilio.EnsureDirectory(uri[0:lastSlash])
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+
+	// Open the lock file.
+	lockURI := strings.TrimSuffix(uri, ".secret") + ".lock"
+	lockFile, err := os.OpenFile(lockURI, os.O_CREATE|os.O_RDWR, 0600) // #nosec G304
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+
+	// Lock the file, waiting for other holders to release it.
+	for {
+		var locked bool
+		locked, err = tryLockFile(lockFile)
+		if err != nil {
+			lockFile.Close() // nolint
+
+			return nil, wrapError(path, err)
+		}
+		if locked {
+			return func() {
+				unlockFile(lockFile) // nolint
+				lockFile.Close()     // nolint
+			}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			lockFile.Close() // nolint
+
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
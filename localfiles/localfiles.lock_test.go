@@ -0,0 +1,78 @@
+package localfiles
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLockSecret tests that locks exclude other holders until released.
+func TestLockSecret(t *testing.T) {
+	uri := localFilesClient.secretURI("locksecret.secret")
+	unlock, err := lockSecret(ctx, uri, "locksecret")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Time out while the lock is held.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = lockSecret(timeoutCtx, uri, "locksecret")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Acquire the lock once it is released.
+	acquired := make(chan struct{})
+	go func() {
+		secondUnlock, err := lockSecret(ctx, uri, "locksecret")
+		if assert.NoError(t, err) {
+			secondUnlock()
+		}
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		assert.Fail(t, "lock was acquired while held")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "lock was not acquired after release")
+	}
+}
+
+// TestDeleteSecretLocked tests that deletes wait for other writers to release the secret's lock.
+func TestDeleteSecretLocked(t *testing.T) {
+	secretPath := "secret/deletesecretlocked"
+	err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+	assert.NoError(t, err)
+	unlock, err := lockSecret(ctx, localFilesClient.secretURI(secretPath+".secret"), secretPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Delete once the lock is released.
+	deleted := make(chan struct{})
+	go func() {
+		err := localFilesClient.DeleteSecret(ctx, secretPath)
+		assert.NoError(t, err)
+		close(deleted)
+	}()
+	select {
+	case <-deleted:
+		assert.Fail(t, "secret was deleted while locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-deleted:
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "secret was not deleted after the lock was released")
+	}
+	_, err = localFilesClient.ReadSecret(ctx, secretPath)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
@@ -0,0 +1,14 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// LookupToken looks up a token.
+// Tokens are not supported by local files.
+func (l *LocalFiles) LookupToken(ctx context.Context, token string) (*secretprovidertype.Token, error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by local files"))
+}
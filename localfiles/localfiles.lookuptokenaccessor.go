@@ -0,0 +1,14 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// LookupTokenAccessor looks up a token by its accessor.
+// Tokens are not supported by local files.
+func (l *LocalFiles) LookupTokenAccessor(ctx context.Context, accessor string) (*secretprovidertype.Token, error) {
+	return nil, secretprovidertype.NewError(secretprovidertype.ErrNotSupported, "", errors.New("tokens are not supported by local files"))
+}
@@ -0,0 +1,69 @@
+package localfiles
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	json "github.com/json-iterator/go"
+)
+
+// metadataURI returns the URI of the sidecar metadata file for a secret file.
+func metadataURI(uri string) string {
+	return strings.TrimSuffix(uri, ".secret") + ".metadata"
+}
+
+// readMetadata reads the metadata for a secret file from its sidecar metadata file, falling back to file information.
+func readMetadata(uri string, path string) (*secretprovidertype.SecretMetadata, error) {
+	fi, err := os.Stat(uri)
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	metadata, err := readSidecar(uri, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fall back to file information.
+	if metadata.Updated == nil {
+		modTime := fi.ModTime().UTC()
+		metadata.Updated = &modTime
+	}
+
+	return metadata, nil
+}
+
+// readSidecar reads the sidecar metadata file for a secret file.
+// Empty metadata is returned if the sidecar metadata file does not exist.
+func readSidecar(uri string, path string) (*secretprovidertype.SecretMetadata, error) {
+	metadata := new(secretprovidertype.SecretMetadata)
+	data, err := ioutil.ReadFile(metadataURI(uri)) // #nosec G304
+	if err != nil {
+		if os.IsNotExist(err) {
+			return metadata, nil
+		}
+
+		return nil, wrapError(path, err)
+	}
+	err = json.Unmarshal(data, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// writeSidecar writes the sidecar metadata file for a secret file.
+func writeSidecar(uri string, path string, metadata *secretprovidertype.SecretMetadata) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(metadataURI(uri), data, 0644)
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// PatchSecret updates individual keys of an existing secret using JSON merge patch semantics.
+// Keys set to nil in the patch are removed.
+func (l *LocalFiles) PatchSecret(ctx context.Context, path string, patch map[string]interface{}) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	if strings.Contains(path, "..") {
+		return invalidPathError(path)
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Lock secret.
+	if !strings.HasSuffix(path, ".secret") {
+		path += ".secret"
+	}
+	unlock, err := lockSecret(ctx, l.secretURI(path), path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// Read, patch and write secret.
+	secret, err := readSecretFile(l.secretURI(path), path)
+	if err != nil {
+		return err
+	}
+	_, err = l.writeSecret(path, secretprovidertype.MergePatch(secret.Data, patch))
+	if err != nil {
+		return err
+	}
+
+	// Log.
+	logger.Info(ctx, "Patched secret.")
+
+	return nil
+}
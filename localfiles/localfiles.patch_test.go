@@ -0,0 +1,74 @@
+package localfiles
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPatchSecret tests PatchSecret().
+func TestPatchSecret(t *testing.T) {
+	secretPath := "secret/patchsecret"
+	err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{
+		"keep":   "value",
+		"nested": map[string]interface{}{"keep": "value", "remove": "value"},
+		"remove": "value",
+		"update": "old",
+	})
+	assert.NoError(t, err)
+
+	// Patch the secret, merging nested objects.
+	err = localFilesClient.PatchSecret(ctx, secretPath, map[string]interface{}{
+		"add":    "value",
+		"nested": map[string]interface{}{"add": "value", "remove": nil},
+		"remove": nil,
+		"update": "new",
+	})
+	assert.NoError(t, err)
+	secret, err := localFilesClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, map[string]interface{}{
+			"add":    "value",
+			"keep":   "value",
+			"nested": map[string]interface{}{"add": "value", "keep": "value"},
+			"update": "new",
+		}, secret.Data)
+	}
+
+	// Patch a secret that does not exist.
+	err = localFilesClient.PatchSecret(ctx, "secret/patchsecretmissing", map[string]interface{}{"value": "one"})
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
+
+// TestPatchSecretConcurrently tests that concurrent patches to different keys are all kept.
+func TestPatchSecretConcurrently(t *testing.T) {
+	secretPath := "secret/patchsecretconcurrently"
+	err := localFilesClient.UpsertSecret(ctx, secretPath, map[string]interface{}{})
+	assert.NoError(t, err)
+
+	// Patch different keys concurrently.
+	const patches = 20
+	var waitGroup sync.WaitGroup
+	for i := 0; i < patches; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			err := localFilesClient.PatchSecret(ctx, secretPath, map[string]interface{}{
+				"key" + strconv.Itoa(i): "value",
+			})
+			assert.NoError(t, err)
+		}(i)
+	}
+	waitGroup.Wait()
+
+	// Every patch is kept.
+	secret, err := localFilesClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Len(t, secret.Data, patches)
+	}
+}
@@ -0,0 +1,42 @@
+package localfiles
+
+import (
+	"io/ioutil"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	json "github.com/json-iterator/go"
+)
+
+const (
+	// Name of the directory holding policies.
+	policyDirectoryName = ".policies"
+
+	// Extension of policy files.
+	policyExtension = ".policy"
+)
+
+// policyDirectoryURI returns the URI of the directory holding policies.
+func (l *LocalFiles) policyDirectoryURI() string {
+	return l.basePath + pathSeparator + policyDirectoryName
+}
+
+// policyURI returns the URI of a policy file.
+func (l *LocalFiles) policyURI(name string) string {
+	return l.policyDirectoryURI() + pathSeparator + name + policyExtension
+}
+
+// readPolicyFile reads and deserializes a policy file.
+func readPolicyFile(uri string, name string) (*secretprovidertype.Policy, error) {
+	policyBytes, err := ioutil.ReadFile(uri) // #nosec G304
+	if err != nil {
+		return nil, wrapError(name, err)
+	}
+	policy := &secretprovidertype.Policy{}
+	err = json.Unmarshal(policyBytes, policy)
+	if err != nil {
+		return nil, err
+	}
+	policy.Name = name
+
+	return policy, nil
+}
@@ -0,0 +1,50 @@
+package localfiles
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPolicies tests WritePolicy(), ReadPolicy(), ListPolicies() and DeletePolicy().
+func TestPolicies(t *testing.T) {
+	policy := &secretprovidertype.Policy{
+		Name: "testpolicy",
+		Rules: []*secretprovidertype.PolicyRule{
+			{Capabilities: []string{secretprovidertype.CapabilityRead, secretprovidertype.CapabilityList}, Path: "secret/*"},
+		},
+	}
+
+	// Write policy.
+	err := localFilesClient.WritePolicy(ctx, policy)
+	assert.NoError(t, err)
+	err = localFilesClient.WritePolicy(ctx, &secretprovidertype.Policy{Name: "../escape"})
+	assert.ErrorIs(t, err, secretprovidertype.ErrInvalidPath)
+	err = localFilesClient.WritePolicy(ctx, &secretprovidertype.Policy{Name: "norules", Rules: []*secretprovidertype.PolicyRule{{Path: "secret/*"}}})
+	assert.Error(t, err)
+
+	// Read policy.
+	readPolicy, err := localFilesClient.ReadPolicy(ctx, "testpolicy")
+	assert.NoError(t, err)
+	assert.Equal(t, policy, readPolicy)
+
+	// List policies.
+	names, err := localFilesClient.ListPolicies(ctx)
+	assert.NoError(t, err)
+	assert.Contains(t, names, "testpolicy")
+
+	// Policies are not listed as secrets.
+	err = localFilesClient.WalkSecretPaths(ctx, nil, func(path string, folder bool, err error) error {
+		assert.NotContains(t, path, policyDirectoryName)
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Delete policy.
+	err = localFilesClient.DeletePolicy(ctx, "testpolicy")
+	assert.NoError(t, err)
+	_, err = localFilesClient.ReadPolicy(ctx, "testpolicy")
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
@@ -4,14 +4,12 @@ package localfiles
 import (
 	"context"
 	"errors"
-	"io/ioutil"
 	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
 	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 	utilio "github.com/bertjohnson/util/io"
-	json "github.com/json-iterator/go"
 )
 
 // ReadSecret returns a secret.
@@ -24,7 +22,7 @@ func (l *LocalFiles) ReadSecret(ctx context.Context, path string) (secret *secre
 		return nil, errors.New("path is required")
 	}
 	if strings.Contains(path, "..") {
-		return nil, errors.New("path contains fobidden sequence (..): " + path)
+		return nil, invalidPathError(path)
 	}
 
 	// Add to context.
@@ -34,21 +32,10 @@ func (l *LocalFiles) ReadSecret(ctx context.Context, path string) (secret *secre
 	if !strings.HasSuffix(path, ".secret") {
 		path += ".secret"
 	}
-	data, err := ioutil.ReadFile(l.basePath + pathSeparator + utilio.NormalizePathSeparators(path))
+	secret, err = readSecretFile(l.basePath+pathSeparator+utilio.NormalizePathSeparators(path), path)
 	if err != nil {
 		return nil, err
 	}
-	dataMap := make(map[string]interface{})
-	err = json.Unmarshal(data, &dataMap)
-	if err != nil {
-		return nil, err
-	}
-
-	// Return secret.
-	secret = &secretprovidertype.Secret{
-		Data: dataMap,
-		Path: path,
-	}
 
 	// Log.
 	logger.Verbose(ctx, "Read secret.")
@@ -1,8 +1,11 @@
 package localfiles
 
 import (
+	"errors"
+	"io/fs"
 	"testing"
 
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,4 +29,18 @@ func TestReadSecret(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, readAInt, writeSecret["a"])
 	}
+
+	// Read nonexistent secret.
+	readSecret, err = localFilesClient.ReadSecret(ctx, "secret/nonexistent/path")
+	assert.Nil(t, readSecret)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	var secretProviderError *secretprovidertype.Error
+	if assert.True(t, errors.As(err, &secretProviderError)) {
+		assert.Equal(t, "secret/nonexistent/path.secret", secretProviderError.Path)
+	}
+
+	// Read invalid path.
+	_, err = localFilesClient.ReadSecret(ctx, "../outside")
+	assert.ErrorIs(t, err, secretprovidertype.ErrInvalidPath)
 }
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"io/ioutil"
-	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
@@ -15,14 +14,17 @@ import (
 
 // ReadAllSecrets reads all secrets.
 func (l *LocalFiles) ReadAllSecrets(ctx context.Context, secretChannel chan *secretprovidertype.Secret, errorChannel chan error) {
+	secretprovidertype.StreamSecrets(ctx, l.WalkSecrets, secretChannel, errorChannel)
+}
+
+// WalkSecrets calls fn for each secret.
+func (l *LocalFiles) WalkSecrets(ctx context.Context, fn secretprovidertype.SecretWalkFunc) error {
 	// Validate parameters.
 	if ctx == nil {
-		errorChannel <- errors.New("context is required")
-
-		close(secretChannel)
-		close(errorChannel)
-
-		return
+		return errors.New("context is required")
+	}
+	if fn == nil {
+		return errors.New("walk function is required")
 	}
 
 	// Add to context.
@@ -32,61 +34,50 @@ func (l *LocalFiles) ReadAllSecrets(ctx context.Context, secretChannel chan *sec
 		ctx = context.WithValue(ctx, contexttype.ObjectIDs, "secretproviderid="+l.ID) // nolint
 	}
 
-	// Read directory.
-	uri := l.basePath
-	fis, err := ioutil.ReadDir(uri)
-	if err != nil {
-		errorChannel <- err
+	// Read all secrets.
+	err := l.WalkSecretPaths(ctx, nil, func(path string, folder bool, err error) error {
+		if err != nil {
+			return fn(path, nil, err)
+		}
 
-		close(secretChannel)
-		close(errorChannel)
+		// Return secret.
+		secret, err := readSecretFile(l.secretURI(path+".secret"), path)
+		if err != nil {
+			return fn(path, nil, err)
+		}
 
-		return
+		return fn(path, secret, nil)
+	})
+	if err != nil {
+		return err
 	}
 
-	// Loop through directory.
-	for _, fi := range fis {
-		fileName := fi.Name()
-		if !fi.IsDir() {
-			lastDot := strings.LastIndex(fileName, ".")
-			fileExtension := strings.ToLower(fileName[lastDot+1:])
-			switch fileExtension {
-			case "secret":
-				// Read and deserialize file.
-				data, err := ioutil.ReadFile(uri + pathSeparator + fileName)
-				if err != nil {
-					errorChannel <- err
-
-					close(secretChannel)
-					close(errorChannel)
-
-					return
-				}
-				dataMap := make(map[string]interface{})
-				err = json.Unmarshal(data, &dataMap)
-				if err != nil {
-					errorChannel <- err
-
-					close(secretChannel)
-					close(errorChannel)
-
-					return
-				}
+	// Log.
+	logger.Verbose(ctx, "Read all secrets.")
 
-				// Return secret.
-				secret := secretprovidertype.Secret{
-					Data: dataMap,
-					Path: fileName[0 : len(fileName)-7],
-				}
+	return nil
+}
 
-				secretChannel <- &secret
-			}
-		}
+// readSecretFile reads and deserializes a secret file.
+func readSecretFile(uri string, path string) (*secretprovidertype.Secret, error) {
+	data, err := ioutil.ReadFile(uri) // #nosec G304
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	dataMap := make(map[string]interface{})
+	err = json.Unmarshal(data, &dataMap)
+	if err != nil {
+		return nil, err
 	}
 
-	// Log.
-	logger.Verbose(ctx, "Read all secrets.")
+	metadata, err := readMetadata(uri, path)
+	if err != nil {
+		return nil, err
+	}
 
-	close(secretChannel)
-	close(errorChannel)
+	return &secretprovidertype.Secret{
+		Data:     dataMap,
+		Metadata: metadata,
+		Path:     path,
+	}, nil
 }
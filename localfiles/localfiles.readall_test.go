@@ -0,0 +1,40 @@
+package localfiles
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWalkSecrets tests WalkSecrets() and ReadAllSecrets().
+func TestWalkSecrets(t *testing.T) {
+	err := localFilesClient.UpsertSecret(ctx, "readallsecret", map[string]interface{}{"a": "b"})
+	assert.NoError(t, err)
+
+	// Walk secrets.
+	secrets := make(map[string]*secretprovidertype.Secret)
+	err = localFilesClient.WalkSecrets(ctx, func(path string, secret *secretprovidertype.Secret, err error) error {
+		assert.NoError(t, err)
+		secrets[path] = secret
+
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.Contains(t, secrets, "readallsecret") {
+		assert.Equal(t, "b", secrets["readallsecret"].Data["a"])
+	}
+
+	// Read all secrets through channels.
+	secretChannel := make(chan *secretprovidertype.Secret)
+	errorChannel := make(chan error)
+	go localFilesClient.ReadAllSecrets(ctx, secretChannel, errorChannel)
+	count := 0
+	for range secretChannel {
+		count++
+	}
+	for err = range errorChannel {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, len(secrets), count)
+}
@@ -0,0 +1,36 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ReadPolicy reads a policy.
+func (l *LocalFiles) ReadPolicy(ctx context.Context, name string) (policy *secretprovidertype.Policy, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	err = secretprovidertype.ValidatePolicyName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read policy.
+	policy, err = readPolicyFile(l.policyURI(name), name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Read policy.")
+
+	return policy, nil
+}
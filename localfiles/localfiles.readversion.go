@@ -0,0 +1,57 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ReadSecretVersion returns a specific version of a secret.
+func (l *LocalFiles) ReadSecretVersion(ctx context.Context, path string, version string) (secret *secretprovidertype.Secret, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	if strings.Contains(path, "..") {
+		return nil, invalidPathError(path)
+	}
+	if _, err = strconv.Atoi(version); err != nil {
+		return nil, errors.New("version must be numeric: " + version)
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read current version from the secret file, and previous versions from history.
+	if !strings.HasSuffix(path, ".secret") {
+		path += ".secret"
+	}
+	currentVersion, err := l.currentVersion(path)
+	if err != nil {
+		return nil, err
+	}
+	if version == currentVersion {
+		secret, err = readSecretFile(l.secretURI(path), path)
+	} else {
+		secret, err = readSecretFile(l.versionURI(path, version), path)
+		if err == nil {
+			secret.Metadata.Version = version
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Read secret version.")
+
+	return secret, nil
+}
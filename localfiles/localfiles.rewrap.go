@@ -0,0 +1,49 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Rewrap re-encrypts ciphertext with the latest version of a named key.
+func (l *LocalFiles) Rewrap(ctx context.Context, name string, ciphertext string) (newCiphertext string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return "", err
+	}
+	if ciphertext == "" {
+		return "", errors.New("ciphertext is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read key.
+	key, err := readEncryptionKey(l.keyURI(name), name)
+	if err != nil {
+		return "", err
+	}
+
+	// Rewrap.
+	plaintext, err := key.decrypt(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	newCiphertext, err = key.encrypt(plaintext)
+	if err != nil {
+		return "", err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Rewrapped data.")
+
+	return newCiphertext, nil
+}
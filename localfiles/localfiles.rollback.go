@@ -0,0 +1,54 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// RollbackSecret restores a previous version of a secret by writing its data as a new version.
+func (l *LocalFiles) RollbackSecret(ctx context.Context, path string, version string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	if strings.Contains(path, "..") {
+		return invalidPathError(path)
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Lock secret.
+	if !strings.HasSuffix(path, ".secret") {
+		path += ".secret"
+	}
+	unlock, err := lockSecret(ctx, l.secretURI(path), path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// Read version.
+	secret, err := l.ReadSecretVersion(ctx, path, version)
+	if err != nil {
+		return err
+	}
+
+	// Write version as the current version.
+	_, err = l.writeSecret(secret.Path, secret.Data)
+	if err != nil {
+		return err
+	}
+
+	// Log.
+	logger.Info(ctx, "Rolled back secret.")
+
+	return nil
+}
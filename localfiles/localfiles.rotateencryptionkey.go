@@ -0,0 +1,54 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RotateEncryptionKey adds a new version of a named key.
+// Data encrypted with previous versions of the key can still be decrypted, and can be rewrapped with the new version.
+func (l *LocalFiles) RotateEncryptionKey(ctx context.Context, name string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	err := secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Lock key.
+	uri := l.keyURI(name)
+	unlock, err := lockSecret(ctx, uri, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// Rotate key.
+	key, err := readEncryptionKey(uri, name)
+	if err != nil {
+		return err
+	}
+	keyVersion, err := newEncryptionKeyVersion(key.Type)
+	if err != nil {
+		return err
+	}
+	key.Versions = append(key.Versions, keyVersion)
+	err = writeEncryptionKey(uri, name, key)
+	if err != nil {
+		return err
+	}
+
+	// Log.
+	logger.Info(ctx, "Rotated encryption key.")
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Sign signs input with a named key.
+func (l *LocalFiles) Sign(ctx context.Context, name string, input []byte) (signature string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return "", err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read key.
+	key, err := readEncryptionKey(l.keyURI(name), name)
+	if err != nil {
+		return "", err
+	}
+
+	// Sign.
+	signature, err = key.sign(input)
+	if err != nil {
+		return "", err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Signed data.")
+
+	return signature, nil
+}
@@ -3,13 +3,10 @@ package localfiles
 import (
 	"context"
 	"errors"
-	"io/ioutil"
 	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
-	utilio "github.com/bertjohnson/util/io"
-	json "github.com/json-iterator/go"
 )
 
 // UpsertSecret creates or updates a secret.
@@ -22,7 +19,7 @@ func (l *LocalFiles) UpsertSecret(ctx context.Context, path string, data map[str
 		return errors.New("path is required")
 	}
 	if strings.Contains(path, "..") {
-		return errors.New("path contains fobidden sequence (..): " + path)
+		return invalidPathError(path)
 	}
 
 	// Add to context.
@@ -32,22 +29,12 @@ func (l *LocalFiles) UpsertSecret(ctx context.Context, path string, data map[str
 	if !strings.HasSuffix(path, ".secret") {
 		path += ".secret"
 	}
-	uri := l.basePath + pathSeparator + utilio.NormalizePathSeparators(path)
-	lastSlash := strings.LastIndex(uri, pathSeparator)
-	uriPath := uri[0:lastSlash]
-	err := utilio.EnsureDirectory(uriPath)
+	unlock, err := lockSecret(ctx, l.secretURI(path), path)
 	if err != nil {
 		return err
 	}
-
-	// Serialize data.
-	dataBytes, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	// Write secret to file.
-	err = ioutil.WriteFile(uri, dataBytes, 0644)
+	defer unlock()
+	_, err = l.writeSecret(path, data)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,45 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Verify verifies the signature of input with a named key.
+func (l *LocalFiles) Verify(ctx context.Context, name string, input []byte, signature string) (valid bool, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return false, errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return false, err
+	}
+	if signature == "" {
+		return false, errors.New("signature is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Read key.
+	key, err := readEncryptionKey(l.keyURI(name), name)
+	if err != nil {
+		return false, err
+	}
+
+	// Verify.
+	valid, err = key.verify(input, signature)
+	if err != nil {
+		return false, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Verified signature.")
+
+	return valid, nil
+}
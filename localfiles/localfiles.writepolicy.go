@@ -0,0 +1,54 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	json "github.com/json-iterator/go"
+)
+
+// WritePolicy creates or replaces a policy.
+// Policies are stored for use by other tools, but are not enforced on local files.
+func (l *LocalFiles) WritePolicy(ctx context.Context, policy *secretprovidertype.Policy) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if policy == nil {
+		return errors.New("policy is required")
+	}
+	err := policy.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, l.ID) // nolint
+
+	// Serialize policy.
+	policyBytes, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	// Write policy.
+	uri := l.policyURI(policy.Name)
+	unlock, err := lockSecret(ctx, uri, policy.Name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	err = ioutil.WriteFile(uri, policyBytes, 0644)
+	if err != nil {
+		return wrapError(policy.Name, err)
+	}
+
+	// Log.
+	logger.Info(ctx, "Wrote policy.")
+
+	return nil
+}
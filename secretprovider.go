@@ -4,12 +4,12 @@ package secretprovider
 import (
 	"context"
 	"errors"
-	"strings"
 
-	"github.com/bertjohnson/secretprovider/awssecretsmanager"
-	"github.com/bertjohnson/secretprovider/localfiles"
+	// Register built-in secret providers.
+	_ "github.com/bertjohnson/secretprovider/awssecretsmanager"
+	_ "github.com/bertjohnson/secretprovider/localfiles"
 	secretprovidertype "github.com/bertjohnson/secretprovider/types"
-	"github.com/bertjohnson/secretprovider/vault"
+	_ "github.com/bertjohnson/secretprovider/vault"
 )
 
 // Get returns a matching ISecretProvider for a secret store definition.
@@ -23,14 +23,21 @@ func Get(ctx context.Context, secretProvider *secretprovidertype.SecretProvider)
 	}
 
 	// Initialize based on the secret store type.
-	switch strings.ToLower(secretProvider.Type) {
-	case "awssecretsmanager":
-		return awssecretsmanager.New(ctx, secretProvider)
-	case "localfiles":
-		return localfiles.New(ctx, secretProvider)
-	case "vault":
-		return vault.New(ctx, secretProvider)
-	default:
+	factory, ok := secretprovidertype.Lookup(secretProvider.Type)
+	if !ok {
 		return nil, errors.New("unknown secret provider type: " + secretProvider.Type)
 	}
+
+	return factory(ctx, secretProvider)
+}
+
+// Register makes a secret provider type available to Get.
+// Type names are case-insensitive. Register panics if the factory is nil or the type name is already registered.
+func Register(typeName string, factory secretprovidertype.Factory) {
+	secretprovidertype.Register(typeName, factory)
+}
+
+// Types returns the sorted names of registered secret provider types.
+func Types() []string {
+	return secretprovidertype.Registered()
 }
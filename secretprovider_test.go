@@ -2,11 +2,14 @@ package secretprovider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/bertjohnson/logger"
+	"github.com/bertjohnson/secretprovider/localfiles"
 	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 	"github.com/bertjohnson/startup"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,3 +44,34 @@ func TestGet(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+// TestRegister tests Register() and Types().
+func TestRegister(t *testing.T) {
+	// Built-in types are registered.
+	assert.Subset(t, Types(), []string{"awssecretsmanager", "localfiles", "vault"})
+
+	// Register a custom type, named uniquely since registrations last for the process.
+	typeName := "CustomFiles" + uuid.New().String()
+	Register(typeName, func(ctx context.Context, secretProvider *secretprovidertype.SecretProvider) (secretprovidertype.ISecretProvider, error) {
+		return localfiles.New(ctx, secretProvider)
+	})
+	assert.Contains(t, Types(), strings.ToLower(typeName))
+	_, err := Get(context.Background(), &secretprovidertype.SecretProvider{
+		Type: strings.ToUpper(typeName),
+		URI:  "test",
+	})
+	assert.NoError(t, err)
+
+	// Duplicate registrations panic.
+	assert.Panics(t, func() {
+		Register(strings.ToLower(typeName), func(ctx context.Context, secretProvider *secretprovidertype.SecretProvider) (secretprovidertype.ISecretProvider, error) {
+			return nil, nil
+		})
+	})
+
+	// Unknown types are rejected.
+	_, err = Get(context.Background(), &secretprovidertype.SecretProvider{
+		Type: "Unknown",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+)
+
+// Sentinel errors shared by every secret provider.
+// Providers wrap backend errors in an Error so that callers can use errors.Is and errors.As regardless of backend.
+var (
+	ErrAlreadyExists    = errors.New("already exists")    // The secret already exists.
+	ErrConflict         = errors.New("conflict")          // The operation conflicts with the current state of the secret.
+	ErrInvalidPath      = errors.New("invalid path")      // The path is malformed or not allowed.
+	ErrNotFound         = errors.New("not found")         // The secret does not exist.
+	ErrNotSupported     = errors.New("not supported")     // The secret store does not support the operation.
+	ErrPermissionDenied = errors.New("permission denied") // The caller is not allowed to perform the operation.
+	ErrSealed           = errors.New("sealed")            // The secret store is sealed.
+	ErrUnavailable      = errors.New("unavailable")       // The secret store could not be reached or is temporarily unable to serve the request.
+)
+
+// Error describes a failed secret provider operation.
+type Error struct {
+	Err  error  // Underlying backend error, if any.
+	Kind error  // Sentinel error classifying the failure (e.g., ErrNotFound).
+	Path string // Path of the secret, if any.
+}
+
+// NewError returns an Error classified by kind, wrapping the underlying backend error.
+func NewError(kind error, path string, err error) error {
+	return &Error{
+		Err:  err,
+		Kind: kind,
+		Path: path,
+	}
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Kind.Error()
+	}
+
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+// Is reports whether the error is classified as target.
+func (e *Error) Is(target error) bool {
+	return e.Kind == target
+}
+
+// Unwrap returns the underlying backend error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"time"
+)
+
+// Health describes whether a secret store can serve requests.
+type Health struct {
+	Checked time.Time `json:"checked"`          // When the secret store was checked.
+	Error   string    `json:"error,omitempty"`  // Why the secret store cannot serve requests, if it cannot.
+	Healthy bool      `json:"healthy"`          // Whether the secret store can serve requests.
+	Sealed  bool      `json:"sealed,omitempty"` // Whether the secret store is sealed.
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+)
+
+// Types of encryption keys.
+const (
+	KeyTypeAES256GCM96 = "aes256-gcm96" // AES-256 in GCM mode with a 96-bit nonce, for encryption.
+	KeyTypeECDSAP256   = "ecdsa-p256"   // ECDSA using the P-256 curve, for signing.
+	KeyTypeED25519     = "ed25519"      // Ed25519, for signing.
+)
+
+// IEncryptionProvider contains methods used to encrypt and sign data with named keys that never leave the secret store.
+// Ciphertexts and signatures record the key version used, so that they remain usable after the key is rotated.
+type IEncryptionProvider interface {
+	CreateEncryptionKey(ctx context.Context, name string, keyType string) error
+	Decrypt(ctx context.Context, name string, ciphertext string) (plaintext []byte, err error)
+	Encrypt(ctx context.Context, name string, plaintext []byte) (ciphertext string, err error)
+	Rewrap(ctx context.Context, name string, ciphertext string) (newCiphertext string, err error)
+	RotateEncryptionKey(ctx context.Context, name string) error
+	Sign(ctx context.Context, name string, input []byte) (signature string, err error)
+	Verify(ctx context.Context, name string, input []byte, signature string) (valid bool, err error)
+}
+
+// ValidateKeyName returns an error if an encryption key name is empty or could traverse directories.
+func ValidateKeyName(name string) error {
+	return validateName(name)
+}
@@ -2,15 +2,36 @@ package types
 
 import (
 	"context"
+	"time"
 )
 
 // ISecretProvider contains methods used to interface with secrets.
 type ISecretProvider interface {
 	GetAutoCertCache(ctx context.Context) AutoCertCache
-	CreateToken(ctx context.Context, id string, displayName string, numUses int, policies []string) (token string, err error)
+	CheckAndSetSecret(ctx context.Context, path string, data map[string]interface{}, expectedVersion string) (version string, err error)
+	Close() error
+	CreateToken(ctx context.Context, options *TokenOptions) (token *Token, err error)
+	DeletePolicy(ctx context.Context, name string) error
 	DeleteSecret(ctx context.Context, path string) error
-	ListSecrets(ctx context.Context, pathChannel chan string, errorChannel chan error)
+	DescribeSecret(ctx context.Context, path string) (metadata *SecretMetadata, err error)
+	Health(ctx context.Context) (health *Health, err error)
+	ListPolicies(ctx context.Context) (names []string, err error)
+	ListSecrets(ctx context.Context, options *ListOptions, pathChannel chan string, errorChannel chan error)
+	ListSecretVersions(ctx context.Context, path string) (versions []*SecretVersion, err error)
+	LookupToken(ctx context.Context, token string) (*Token, error)
+	LookupTokenAccessor(ctx context.Context, accessor string) (*Token, error)
+	PatchSecret(ctx context.Context, path string, patch map[string]interface{}) error
 	ReadAllSecrets(ctx context.Context, secretChannel chan *Secret, errorChannel chan error)
+	ReadPolicy(ctx context.Context, name string) (policy *Policy, err error)
 	ReadSecret(ctx context.Context, path string) (secret *Secret, err error)
+	ReadSecretVersion(ctx context.Context, path string, version string) (secret *Secret, err error)
+	RenewToken(ctx context.Context, token string, increment time.Duration) (*Token, error)
+	RenewTokenAccessor(ctx context.Context, accessor string, increment time.Duration) (*Token, error)
+	RevokeToken(ctx context.Context, token string) error
+	RevokeTokenAccessor(ctx context.Context, accessor string) error
+	RollbackSecret(ctx context.Context, path string, version string) error
 	UpsertSecret(ctx context.Context, path string, data map[string]interface{}) error
+	WalkSecretPaths(ctx context.Context, options *ListOptions, fn PathWalkFunc) error
+	WalkSecrets(ctx context.Context, fn SecretWalkFunc) error
+	WritePolicy(ctx context.Context, policy *Policy) error
 }
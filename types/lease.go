@@ -0,0 +1,13 @@
+package types
+
+import (
+	"time"
+)
+
+// Lease describes the lease of a dynamic secret, which is revoked by the secret store when it expires.
+type Lease struct {
+	Duration   time.Duration `json:"duration,omitempty"`   // Duration of the lease when it was issued or last renewed.
+	Expiration *time.Time    `json:"expiration,omitempty"` // Time the lease expires unless renewed.
+	ID         string        `json:"id,omitempty"`         // ID of the lease, used to renew or revoke it.
+	Renewable  bool          `json:"renewable,omitempty"`  // Whether the lease can be renewed.
+}
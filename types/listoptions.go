@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+)
+
+// ListOptions controls which secret paths are listed.
+// A nil *ListOptions lists every secret recursively.
+type ListOptions struct {
+	IncludeDeleted bool              // Whether to include deleted secrets that can still be restored (e.g., scheduled for deletion or soft-deleted), where supported.
+	Prefix         string            // Folder to list, using "/" as the separator (e.g., "app/database"). Empty lists from the root.
+	NonRecursive   bool              // Whether to list only the immediate secrets and folders under Prefix. Otherwise, folders are descended into and only leaf secrets are listed.
+	Tags           map[string]string // Tags that listed secrets must all have.
+}
+
+// Folder returns the normalized folder prefix, which is empty or ends with "/".
+func (o *ListOptions) Folder() string {
+	if o == nil {
+		return ""
+	}
+	folder := strings.Trim(strings.ReplaceAll(o.Prefix, "\\", "/"), "/")
+	if folder == "" {
+		return ""
+	}
+
+	return folder + "/"
+}
+
+// IsRecursive reports whether folders should be descended into.
+func (o *ListOptions) IsRecursive() bool {
+	return o == nil || !o.NonRecursive
+}
+
+// MatchesTags reports whether secret tags contain every tag required by the options.
+func (o *ListOptions) MatchesTags(tags map[string]string) bool {
+	if o == nil {
+		return true
+	}
+	for key, value := range o.Tags {
+		if tagValue, ok := tags[key]; !ok || tagValue != value {
+			return false
+		}
+	}
+
+	return true
+}
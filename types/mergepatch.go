@@ -0,0 +1,28 @@
+package types
+
+// MergePatch applies a JSON merge patch (RFC 7396) to secret data, returning the patched data.
+// Keys set to nil in the patch are removed, nested objects are merged recursively and other values replace existing ones.
+// The target is not modified.
+func MergePatch(target map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(target)+len(patch))
+	for key, value := range target {
+		result[key] = value
+	}
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(result, key)
+
+			continue
+		}
+		patchMap, ok := patchValue.(map[string]interface{})
+		if !ok {
+			result[key] = patchValue
+
+			continue
+		}
+		targetMap, _ := result[key].(map[string]interface{}) // nolint
+		result[key] = MergePatch(targetMap, patchMap)
+	}
+
+	return result
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMergePatch tests MergePatch() using the examples from RFC 7396.
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		target   map[string]interface{}
+		patch    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			target:   map[string]interface{}{"a": "b"},
+			patch:    map[string]interface{}{"a": "c"},
+			expected: map[string]interface{}{"a": "c"},
+		},
+		{
+			target:   map[string]interface{}{"a": "b"},
+			patch:    map[string]interface{}{"b": "c"},
+			expected: map[string]interface{}{"a": "b", "b": "c"},
+		},
+		{
+			target:   map[string]interface{}{"a": "b"},
+			patch:    map[string]interface{}{"a": nil},
+			expected: map[string]interface{}{},
+		},
+		{
+			target:   map[string]interface{}{"a": "b", "b": "c"},
+			patch:    map[string]interface{}{"a": nil},
+			expected: map[string]interface{}{"b": "c"},
+		},
+		{
+			target:   map[string]interface{}{"a": []interface{}{"b"}},
+			patch:    map[string]interface{}{"a": "c"},
+			expected: map[string]interface{}{"a": "c"},
+		},
+		{
+			target:   map[string]interface{}{"a": "c"},
+			patch:    map[string]interface{}{"a": []interface{}{"b"}},
+			expected: map[string]interface{}{"a": []interface{}{"b"}},
+		},
+		{
+			target:   map[string]interface{}{"a": map[string]interface{}{"b": "c"}},
+			patch:    map[string]interface{}{"a": map[string]interface{}{"b": "d", "c": nil}},
+			expected: map[string]interface{}{"a": map[string]interface{}{"b": "d"}},
+		},
+		{
+			target:   map[string]interface{}{"a": []interface{}{map[string]interface{}{"b": "c"}}},
+			patch:    map[string]interface{}{"a": []interface{}{1}},
+			expected: map[string]interface{}{"a": []interface{}{1}},
+		},
+		{
+			target:   map[string]interface{}{"e": nil},
+			patch:    map[string]interface{}{"a": 1},
+			expected: map[string]interface{}{"e": nil, "a": 1},
+		},
+		{
+			target:   map[string]interface{}{},
+			patch:    map[string]interface{}{"a": map[string]interface{}{"bb": map[string]interface{}{"ccc": nil}}},
+			expected: map[string]interface{}{"a": map[string]interface{}{"bb": map[string]interface{}{}}},
+		},
+		{
+			target:   nil,
+			patch:    map[string]interface{}{"a": "b"},
+			expected: map[string]interface{}{"a": "b"},
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, MergePatch(test.target, test.patch))
+	}
+
+	// The target is not modified.
+	target := map[string]interface{}{"a": map[string]interface{}{"b": "c"}}
+	MergePatch(target, map[string]interface{}{"a": map[string]interface{}{"b": nil}})
+	assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": "c"}}, target)
+}
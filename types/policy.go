@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// Capabilities granted by policy rules.
+const (
+	CapabilityCreate = "create" // Create secrets.
+	CapabilityDelete = "delete" // Delete secrets.
+	CapabilityDeny   = "deny"   // Deny all access, overriding other capabilities.
+	CapabilityList   = "list"   // List secrets.
+	CapabilityPatch  = "patch"  // Partially update secrets.
+	CapabilityRead   = "read"   // Read secrets.
+	CapabilitySudo   = "sudo"   // Access root-protected paths.
+	CapabilityUpdate = "update" // Update secrets.
+)
+
+// Policy describes an access control policy that can be attached to tokens.
+type Policy struct {
+	Name  string        `json:"name"`            // Name of the policy.
+	Rules []*PolicyRule `json:"rules,omitempty"` // Rules granting capabilities on paths.
+}
+
+// PolicyRule grants capabilities on a path.
+type PolicyRule struct {
+	AllowedParameters  map[string][]interface{} `json:"allowedParameters,omitempty"`  // Parameters that may be set, with their allowed values. An empty list allows any value and a "*" key allows any other parameter.
+	Capabilities       []string                 `json:"capabilities"`                 // Capabilities granted on the path (e.g., read, list).
+	DeniedParameters   map[string][]interface{} `json:"deniedParameters,omitempty"`   // Parameters that may not be set, with their denied values. An empty list denies any value and a "*" key denies every parameter.
+	MaxWrappingTTL     time.Duration            `json:"maxWrappingTTL,omitempty"`     // Maximum time to live of wrapped responses, or 0 for no maximum.
+	MinWrappingTTL     time.Duration            `json:"minWrappingTTL,omitempty"`     // Minimum time to live of wrapped responses, or 0 if responses need not be wrapped.
+	Path               string                   `json:"path"`                         // Path the rule applies to, as addressed by the secret store, optionally ending with a * wildcard.
+	RequiredParameters []string                 `json:"requiredParameters,omitempty"` // Parameters that must be set.
+}
+
+// ValidatePolicyName returns an error if a policy name is empty or could traverse directories.
+func ValidatePolicyName(name string) error {
+	return validateName(name)
+}
+
+// Validate returns an error if the policy has an invalid name or an incomplete rule.
+func (p *Policy) Validate() error {
+	err := ValidatePolicyName(p.Name)
+	if err != nil {
+		return err
+	}
+	for _, rule := range p.Rules {
+		if rule == nil || rule.Path == "" {
+			return errors.New("rule path is required")
+		}
+		if len(rule.Capabilities) == 0 {
+			return errors.New("rule capabilities are required: " + rule.Path)
+		}
+		if rule.MinWrappingTTL < 0 || rule.MaxWrappingTTL < 0 || (rule.MaxWrappingTTL > 0 && rule.MinWrappingTTL > rule.MaxWrappingTTL) {
+			return errors.New("rule wrapping TTLs are invalid: " + rule.Path)
+		}
+		for _, parameters := range []map[string][]interface{}{rule.AllowedParameters, rule.DeniedParameters} {
+			for name, values := range parameters {
+				for _, value := range values {
+					switch value.(type) {
+					case bool, float64, int, int64, string:
+					default:
+						return errors.New("rule parameter values must be booleans, numbers or strings: " + rule.Path + " " + name)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
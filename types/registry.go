@@ -0,0 +1,61 @@
+package types
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Factory creates an ISecretProvider from a secret provider definition.
+type Factory func(ctx context.Context, secretProvider *SecretProvider) (ISecretProvider, error)
+
+var (
+	// Registered factories, keyed by lowercase type name.
+	factories     = make(map[string]Factory)
+	factoriesLock sync.RWMutex
+)
+
+// Register makes a secret provider type available by name.
+// Type names are case-insensitive. Register panics if the factory is nil or the type name is already registered.
+func Register(typeName string, factory Factory) {
+	if typeName == "" {
+		panic("secret provider type name is required")
+	}
+	if factory == nil {
+		panic("secret provider factory is required: " + typeName)
+	}
+
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+
+	key := strings.ToLower(typeName)
+	if _, ok := factories[key]; ok {
+		panic("secret provider type already registered: " + typeName)
+	}
+	factories[key] = factory
+}
+
+// Lookup returns the factory registered for a secret provider type.
+func Lookup(typeName string) (Factory, bool) {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	factory, ok := factories[strings.ToLower(typeName)]
+
+	return factory, ok
+}
+
+// Registered returns the sorted names of registered secret provider types.
+func Registered() []string {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	typeNames := make([]string, 0, len(factories))
+	for typeName := range factories {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	return typeNames
+}
@@ -2,6 +2,8 @@ package types
 
 // Secret contains metadata for a secret.
 type Secret struct {
-	Data map[string]interface{} `json:"data,omitempty" validate:"required"` // Secret data.
-	Path string                 `json:"path,omitempty" validate:"required"` // Path.
+	Data     map[string]interface{} `json:"data,omitempty" validate:"required"` // Secret data.
+	Lease    *Lease                 `json:"lease,omitempty"`                    // Lease of a dynamic secret, if any.
+	Metadata *SecretMetadata        `json:"metadata,omitempty"`                 // Descriptive metadata, if available.
+	Path     string                 `json:"path,omitempty" validate:"required"` // Path.
 }
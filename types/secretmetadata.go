@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+)
+
+// Keys used to store descriptive metadata alongside tags in backends without dedicated fields.
+const (
+	MetadataDescriptionKey = "description"
+	MetadataOwnerKey       = "owner"
+)
+
+// SecretMetadata contains descriptive metadata for a secret.
+type SecretMetadata struct {
+	Created     *time.Time        `json:"created,omitempty"`     // Time the secret was created.
+	Description string            `json:"description,omitempty"` // Description.
+	Owner       string            `json:"owner,omitempty"`       // Owner.
+	Tags        map[string]string `json:"tags,omitempty"`        // Tags or labels.
+	Updated     *time.Time        `json:"updated,omitempty"`     // Time the secret was last updated.
+	Version     string            `json:"version,omitempty"`     // Identifier of the current version.
+}
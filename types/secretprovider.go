@@ -12,11 +12,28 @@ type SecretProvider struct {
 	ParentNodeID   string `json:"-"` // ID of the parent node.
 
 	// Persistent metadata.
-	ClientID     string   `env:"SECRETSTORE_CLIENTID" json:"clientID,omitempty"`             // Client ID used by the secret store provider.
-	ClientSecret string   `env:"SECRETSTORE_CLIENTSECRET" json:"clientSecret,omitempty"`     // Shared secret used by the secret store provider.
-	ClientToken  string   `env:"SECRETSTORE_CLIENTTOKEN" json:"clientToken,omitempty"`       // Optional token used by the secret store provider.
-	Region       string   `env:"SECRETSTORE_REGION" json:"region,omitempty"`                 // Region of the secret store provider.
-	Type         string   `env:"SECRETSTORE_TYPE" json:"type,omitempty" validate:"required"` // Type of secret storage (e.g., Vault).
-	UnsealShards []string `env:"SECRETSTORE_UNSEALSHARDS" json:"unsealShards,omitempty"`     // Shared secrets to unseal the secret store.
-	URI          string   `env:"SECRETSTORE_URI" json:"uri,omitempty"`                       // Address of the secret store.
+	AuthMethod    string   `env:"SECRETSTORE_AUTHMETHOD" json:"authMethod,omitempty"`         // Method used to authenticate with the secret store provider (e.g., token, approle).
+	AuthMount     string   `env:"SECRETSTORE_AUTHMOUNT" json:"authMount,omitempty"`           // Optional mount of the authentication method, if not the default.
+	AuthRole      string   `env:"SECRETSTORE_AUTHROLE" json:"authRole,omitempty"`             // Role to authenticate as, for role-based authentication methods (e.g., jwt, kubernetes).
+	AuthTokenFile string   `env:"SECRETSTORE_AUTHTOKENFILE" json:"authTokenFile,omitempty"`   // Path of a file holding the token used to authenticate (e.g., a service account token).
+	ClientID      string   `env:"SECRETSTORE_CLIENTID" json:"clientID,omitempty"`             // Client ID used by the secret store provider.
+	ClientSecret  string   `env:"SECRETSTORE_CLIENTSECRET" json:"clientSecret,omitempty"`     // Shared secret used by the secret store provider.
+	ClientToken   string   `env:"SECRETSTORE_CLIENTTOKEN" json:"clientToken,omitempty"`       // Optional token used by the secret store provider.
+	Mount         string   `env:"SECRETSTORE_MOUNT" json:"mount,omitempty"`                   // Mount of the secrets engine within the secret store (e.g., secret).
+	Namespace     string   `env:"SECRETSTORE_NAMESPACE" json:"namespace,omitempty"`           // Optional namespace within the secret store (e.g., a Vault Enterprise namespace).
+	PKIMount      string   `env:"SECRETSTORE_PKIMOUNT" json:"pkiMount,omitempty"`             // Mount of the PKI secrets engine used to issue certificates, if not the default.
+	PKIRole       string   `env:"SECRETSTORE_PKIROLE" json:"pkiRole,omitempty"`               // Default role of the PKI secrets engine used to issue certificates.
+	PathPrefix    string   `env:"SECRETSTORE_PATHPREFIX" json:"pathPrefix,omitempty"`         // Optional prefix applied to all secret paths within the mount.
+	Region        string   `env:"SECRETSTORE_REGION" json:"region,omitempty"`                 // Region of the secret store provider.
+	TLSCACert     string   `env:"SECRETSTORE_TLSCACERT" json:"tlsCACert,omitempty"`           // Path of a PEM-encoded CA certificate bundle used to verify the secret store.
+	TLSClientCert string   `env:"SECRETSTORE_TLSCLIENTCERT" json:"tlsClientCert,omitempty"`   // Path of a PEM-encoded client certificate presented to the secret store.
+	TLSClientKey  string   `env:"SECRETSTORE_TLSCLIENTKEY" json:"tlsClientKey,omitempty"`     // Path of the PEM-encoded private key of the client certificate.
+	TLSMinVersion string   `env:"SECRETSTORE_TLSMINVERSION" json:"tlsMinVersion,omitempty"`   // Minimum TLS version (e.g., 1.2).
+	TLSServerName string   `env:"SECRETSTORE_TLSSERVERNAME" json:"tlsServerName,omitempty"`   // Server name used to verify the secret store's certificate, if not the host of the URI.
+	TLSSkipVerify bool     `env:"SECRETSTORE_TLSSKIPVERIFY" json:"tlsSkipVerify,omitempty"`   // Whether to skip verifying the secret store's certificate.
+	TransitMount  string   `env:"SECRETSTORE_TRANSITMOUNT" json:"transitMount,omitempty"`     // Mount of the transit secrets engine used for encryption, if not the default.
+	Type          string   `env:"SECRETSTORE_TYPE" json:"type,omitempty" validate:"required"` // Type of secret storage (e.g., Vault).
+	UnsealShards  []string `env:"SECRETSTORE_UNSEALSHARDS" json:"unsealShards,omitempty"`     // Shared secrets to unseal the secret store.
+	URI           string   `env:"SECRETSTORE_URI" json:"uri,omitempty"`                       // Address of the secret store.
+	URIs          []string `env:"SECRETSTORE_URIS" json:"uris,omitempty"`                     // Addresses of the nodes of a highly available secret store, tried in order.
 }
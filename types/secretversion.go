@@ -0,0 +1,15 @@
+package types
+
+import (
+	"time"
+)
+
+// SecretVersion describes a version of a secret.
+type SecretVersion struct {
+	Created   *time.Time `json:"created,omitempty"`   // Time the version was created.
+	Current   bool       `json:"current,omitempty"`   // Whether the version is the current version.
+	Deleted   *time.Time `json:"deleted,omitempty"`   // Time the version was deleted, if any.
+	Destroyed bool       `json:"destroyed,omitempty"` // Whether the version's data was permanently destroyed.
+	Labels    []string   `json:"labels,omitempty"`    // Staging labels attached to the version.
+	Version   string     `json:"version,omitempty"`   // Version identifier.
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+)
+
+// Token describes an authentication token issued by a secret store.
+type Token struct {
+	Accessor   string            `json:"accessor,omitempty"`   // Accessor used to manage the token without knowing its ID.
+	Expiration *time.Time        `json:"expiration,omitempty"` // Time the token expires, if it expires.
+	ID         string            `json:"id,omitempty"`         // Token used to authenticate, omitted when looked up by accessor.
+	Metadata   map[string]string `json:"metadata,omitempty"`   // Metadata attached to the token.
+	Orphan     bool              `json:"orphan,omitempty"`     // Whether the token has no parent token.
+	Policies   []string          `json:"policies,omitempty"`   // Policies attached to the token.
+	Renewable  bool              `json:"renewable,omitempty"`  // Whether the token can be renewed.
+	TTL        time.Duration     `json:"ttl,omitempty"`        // Remaining time to live of the token.
+}
+
+// TokenOptions configures the creation of a token.
+type TokenOptions struct {
+	DisplayName    string            // Display name of the token.
+	ExplicitMaxTTL time.Duration     // Maximum time to live of the token, which renewals cannot extend.
+	ID             string            // Optional ID of the token, generated if empty.
+	Metadata       map[string]string // Metadata attached to the token.
+	NumUses        int               // Number of uses of the token, or 0 for unlimited uses.
+	Orphan         bool              // Whether the token has no parent token, so that it is not revoked with its parent.
+	Period         time.Duration     // Period after which the token expires unless renewed, for periodic tokens.
+	Policies       []string          // Policies attached to the token.
+	Renewable      *bool             // Whether the token can be renewed, if not the secret store's default.
+	Role           string            // Optional token role to create the token against.
+	TTL            time.Duration     // Time to live of the token.
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"errors"
+	"strings"
+)
+
+// validateName returns an error if the name of a policy or key is empty or could traverse directories.
+func validateName(name string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+	if strings.Contains(name, "/") || strings.Contains(name, "\\") || strings.Contains(name, "..") {
+		return NewError(ErrInvalidPath, name, errors.New("name cannot traverse directories"))
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+// StopWalk may be returned by a walk function to stop walking without reporting an error.
+var StopWalk = errors.New("stop walk") // nolint
+
+// PathWalkFunc is called by WalkSecretPaths for each secret path.
+// Folder entries, reported only by non-recursive listings, have folder set and a path ending with "/".
+// If an individual path could not be listed, err describes the failure and returning nil continues the walk.
+// Returning a non-nil error stops the walk; StopWalk stops it without error.
+type PathWalkFunc func(path string, folder bool, err error) error
+
+// SecretWalkFunc is called by WalkSecrets for each secret.
+// If an individual secret could not be read, secret is nil, err describes the failure and returning nil continues the walk.
+// Returning a non-nil error stops the walk; StopWalk stops it without error.
+type SecretWalkFunc func(path string, secret *Secret, err error) error
+
+// StreamSecretPaths adapts a WalkSecretPaths implementation to the channel-based ListSecrets contract.
+// Only secret paths are sent; folder entries are reported by WalkSecretPaths alone.
+// Sends stop when ctx is done, per-path errors are sent to errorChannel without ending the stream, and both channels are closed on return.
+func StreamSecretPaths(ctx context.Context, options *ListOptions, walk func(ctx context.Context, options *ListOptions, fn PathWalkFunc) error, pathChannel chan string, errorChannel chan error) {
+	defer close(pathChannel)
+	defer close(errorChannel)
+
+	done := contextDone(ctx)
+	err := walk(ctx, options, func(path string, folder bool, err error) error {
+		if err != nil {
+			return sendError(ctx, errorChannel, err)
+		}
+		if folder {
+			return nil
+		}
+
+		select {
+		case pathChannel <- path:
+			return nil
+		case <-done:
+			return ctx.Err()
+		}
+	})
+	if err != nil && (ctx == nil || ctx.Err() == nil) {
+		sendError(ctx, errorChannel, err) // nolint
+	}
+}
+
+// StreamSecrets adapts a WalkSecrets implementation to the channel-based ReadAllSecrets contract.
+// Sends stop when ctx is done, per-secret errors are sent to errorChannel without ending the stream, and both channels are closed on return.
+func StreamSecrets(ctx context.Context, walk func(ctx context.Context, fn SecretWalkFunc) error, secretChannel chan *Secret, errorChannel chan error) {
+	defer close(secretChannel)
+	defer close(errorChannel)
+
+	done := contextDone(ctx)
+	err := walk(ctx, func(path string, secret *Secret, err error) error {
+		if err != nil {
+			return sendError(ctx, errorChannel, err)
+		}
+
+		select {
+		case secretChannel <- secret:
+			return nil
+		case <-done:
+			return ctx.Err()
+		}
+	})
+	if err != nil && (ctx == nil || ctx.Err() == nil) {
+		sendError(ctx, errorChannel, err) // nolint
+	}
+}
+
+// contextDone returns the done channel of ctx, or nil if ctx is nil.
+func contextDone(ctx context.Context) <-chan struct{} {
+	if ctx == nil {
+		return nil
+	}
+
+	return ctx.Done()
+}
+
+// sendError sends err to errorChannel unless ctx is done first.
+func sendError(ctx context.Context, errorChannel chan error, err error) error {
+	select {
+	case errorChannel <- err:
+		return nil
+	case <-contextDone(ctx):
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// Supported authentication methods.
+	authMethodAppRole    = "approle"
+	authMethodCert       = "cert"
+	authMethodJWT        = "jwt"
+	authMethodKubernetes = "kubernetes"
+	authMethodToken      = "token"
+
+	// Path of the service account token mounted into Kubernetes pods.
+	kubernetesTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token" // #nosec G101
+
+	// Time before a token expires at which to authenticate again.
+	reauthenticateMargin = 30 * time.Second
+)
+
+// authenticator logs in to Vault, returning the authentication secret.
+type authenticator func(ctx context.Context, client *vault.Client) (*vault.Secret, error)
+
+// newAuthenticator returns the authenticator for the configured authentication method, or nil for static tokens.
+func newAuthenticator(secretProvider *secretprovidertype.SecretProvider) (authenticator, error) {
+	authMethod := strings.ToLower(secretProvider.AuthMethod)
+	authMount := strings.Trim(secretProvider.AuthMount, "/")
+	if authMount == "" {
+		authMount = authMethod
+	}
+
+	switch authMethod {
+	case authMethodToken:
+		if secretProvider.ClientSecret == "" {
+			return nil, errors.New("Vault token is required")
+		}
+
+		return nil, nil
+	case authMethodAppRole:
+		if secretProvider.ClientID == "" {
+			return nil, errors.New("AppRole role ID is required")
+		}
+		if secretProvider.ClientSecret == "" {
+			return nil, errors.New("AppRole secret ID is required")
+		}
+
+		return loginAuthenticator(authMount, map[string]interface{}{
+			"role_id":   secretProvider.ClientID,
+			"secret_id": secretProvider.ClientSecret,
+		}), nil
+	case authMethodCert:
+		if secretProvider.TLSClientCert == "" {
+			return nil, errors.New("TLS client certificate is required")
+		}
+		credentials := make(map[string]interface{})
+		if secretProvider.AuthRole != "" {
+			credentials["name"] = secretProvider.AuthRole
+		}
+
+		return loginAuthenticator(authMount, credentials), nil
+	case authMethodJWT, authMethodKubernetes:
+		if secretProvider.AuthRole == "" {
+			return nil, errors.New("Vault authentication role is required")
+		}
+		file := tokenFile(secretProvider)
+		if file == "" {
+			return nil, errors.New("Vault authentication token file is required")
+		}
+
+		return tokenFileAuthenticator(authMount, secretProvider.AuthRole, file), nil
+	default:
+		return nil, errors.New("unsupported Vault authentication method: " + secretProvider.AuthMethod)
+	}
+}
+
+// tokenFile returns the path of the file holding the token used to authenticate, if any.
+func tokenFile(secretProvider *secretprovidertype.SecretProvider) string {
+	switch strings.ToLower(secretProvider.AuthMethod) {
+	case authMethodJWT:
+		return secretProvider.AuthTokenFile
+	case authMethodKubernetes:
+		if secretProvider.AuthTokenFile == "" {
+			return kubernetesTokenFile
+		}
+
+		return secretProvider.AuthTokenFile
+	default:
+		return ""
+	}
+}
+
+// tokenFileAuthenticator returns an authenticator that logs in with a role and a JWT read from a file.
+// The file is read on every login so that rotated tokens are used.
+func tokenFileAuthenticator(authMount string, role string, file string) authenticator {
+	return func(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+		jwt, err := ioutil.ReadFile(file) // #nosec G304
+		if err != nil {
+			return nil, err
+		}
+
+		return loginAuthenticator(authMount, map[string]interface{}{
+			"jwt":  strings.TrimSpace(string(jwt)),
+			"role": role,
+		})(ctx, client)
+	}
+}
+
+// loginAuthenticator returns an authenticator that writes credentials to the login endpoint of an authentication method.
+func loginAuthenticator(authMount string, credentials map[string]interface{}) authenticator {
+	return func(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+		return client.Logical().WriteWithContext(ctx, "auth/"+authMount+"/login", credentials)
+	}
+}
+
+// login authenticates with Vault and uses the resulting token.
+func (v *Vault) login(ctx context.Context) error {
+	v.authLock.Lock()
+	defer v.authLock.Unlock()
+
+	return v.loginLocked(ctx)
+}
+
+// loginLocked authenticates with Vault while authLock is held.
+func (v *Vault) loginLocked(ctx context.Context) error {
+	tokenFileTime := v.tokenFileModified()
+	authSecret, err := v.authenticate(ctx, v.loginClient)
+	if err != nil {
+		return wrapError("", err)
+	}
+	if authSecret == nil || authSecret.Auth == nil || authSecret.Auth.ClientToken == "" {
+		return errors.New("Vault login did not return a token")
+	}
+	v.client.SetToken(authSecret.Auth.ClientToken)
+	v.authSecret = authSecret
+	v.tokenFileTime = tokenFileTime
+	v.tokenExpiration = time.Time{}
+	if authSecret.Auth.LeaseDuration > 0 {
+		v.tokenExpiration = time.Now().Add(time.Duration(authSecret.Auth.LeaseDuration) * time.Second)
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Authenticated with Vault.")
+
+	return nil
+}
+
+// tokenFileModified returns the modification time of the token file, if any.
+func (v *Vault) tokenFileModified() time.Time {
+	if v.tokenFile == "" {
+		return time.Time{}
+	}
+	fi, err := os.Stat(v.tokenFile)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return fi.ModTime()
+}
+
+// refreshToken authenticates again before a request if the token is about to expire or the token file was rotated.
+// It is registered as a request callback, so failures are logged and the request proceeds with the current token.
+func (v *Vault) refreshToken(request *vault.Request) {
+	v.authLock.Lock()
+	defer v.authLock.Unlock()
+	if v.authenticate == nil {
+		return
+	}
+	expiring := !v.tokenExpiration.IsZero() && time.Until(v.tokenExpiration) <= reauthenticateMargin
+	rotated := v.tokenFile != "" && !v.tokenFileModified().Equal(v.tokenFileTime)
+	if !expiring && !rotated {
+		return
+	}
+
+	ctx := context.WithValue(context.Background(), contexttype.SecretProviderID, v.ID) // nolint
+	err := v.loginLocked(ctx)
+	if err != nil {
+		logger.Warn(ctx, "Unable to authenticate with Vault: "+err.Error())
+
+		return
+	}
+	request.ClientToken = v.client.Token()
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAppRoleAuthentication tests authenticating with AppRole.
+func TestAppRoleAuthentication(t *testing.T) {
+	// Enable AppRole and create a role.
+	err := vaultClient.client.Sys().EnableAuthWithOptionsWithContext(ctx, "approle", &vault.EnableAuthOptions{Type: "approle"})
+	assert.NoError(t, err)
+	_, err = vaultClient.client.Logical().WriteWithContext(ctx, "auth/approle/role/tests", map[string]interface{}{
+		"token_policies": "root",
+		"token_ttl":      "1m",
+	})
+	assert.NoError(t, err)
+	roleID, err := vaultClient.client.Logical().ReadWithContext(ctx, "auth/approle/role/tests/role-id")
+	assert.NoError(t, err)
+	secretID, err := vaultClient.client.Logical().WriteWithContext(ctx, "auth/approle/role/tests/secret-id", nil)
+	assert.NoError(t, err)
+
+	// Log in and read a secret.
+	appRoleClient, err := New(ctx, &secretprovidertype.SecretProvider{
+		AuthMethod:   "AppRole",
+		ClientID:     roleID.Data["role_id"].(string),
+		ClientSecret: secretID.Data["secret_id"].(string),
+		ID:           "d1f0cf52-2f41-4e5f-9a4c-0f4fb0c3f8a1",
+		Type:         "Vault",
+	})
+	if assert.NoError(t, err) {
+		assert.False(t, appRoleClient.tokenExpiration.IsZero())
+		err = appRoleClient.UpsertSecret(ctx, "secret/approlesecret", map[string]interface{}{"a": "b"})
+		assert.NoError(t, err)
+	}
+
+	// Reject missing credentials.
+	_, err = New(ctx, &secretprovidertype.SecretProvider{
+		AuthMethod: "approle",
+		ClientID:   "role",
+		Type:       "Vault",
+	})
+	assert.Error(t, err)
+}
+
+// TestTokenFileAuthentication tests configuring JWT and Kubernetes authentication.
+func TestTokenFileAuthentication(t *testing.T) {
+	// Default to the Kubernetes service account token.
+	assert.Equal(t, kubernetesTokenFile, tokenFile(&secretprovidertype.SecretProvider{AuthMethod: "kubernetes"}))
+	_, err := newAuthenticator(&secretprovidertype.SecretProvider{AuthMethod: "kubernetes", AuthRole: "tests"})
+	assert.NoError(t, err)
+
+	// Require a role and token file.
+	_, err = newAuthenticator(&secretprovidertype.SecretProvider{AuthMethod: "jwt", AuthRole: "tests"})
+	assert.Error(t, err)
+	_, err = newAuthenticator(&secretprovidertype.SecretProvider{AuthMethod: "kubernetes"})
+	assert.Error(t, err)
+
+	// Detect rotated token files.
+	file := t.TempDir() + "/token"
+	err = ioutil.WriteFile(file, []byte("one"), 0600)
+	assert.NoError(t, err)
+	v := &Vault{tokenFile: file}
+	modified := v.tokenFileModified()
+	assert.False(t, modified.IsZero())
+	err = os.Chtimes(file, modified.Add(time.Minute), modified.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.NotEqual(t, modified, v.tokenFileModified())
+}
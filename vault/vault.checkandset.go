@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// CheckAndSetSecret creates or updates a secret only if its current version matches the expected version.
+// An empty expected version requires that the secret does not exist. A mismatch returns ErrConflict.
+func (v *Vault) CheckAndSetSecret(ctx context.Context, path string, data map[string]interface{}, expectedVersion string) (version string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	if path == "" {
+		return "", errors.New("path is required")
+	}
+	cas := 0
+	if expectedVersion != "" {
+		cas, err = parseVersion(expectedVersion)
+		if err != nil {
+			return "", err
+		}
+	}
+	path, err = v.secretPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Write secret using check-and-set.
+	err = v.requireKVv2(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	kvSecret, err := v.client.KVv2(v.mount).Put(ctx, v.kvPath(path), data, vault.WithCheckAndSet(cas))
+	if err != nil {
+		return "", wrapError(path, err)
+	}
+	if kvSecret.VersionMetadata != nil {
+		version = strconv.Itoa(kvSecret.VersionMetadata.Version)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Upserted secret: "+path)
+	} else {
+		logger.Info(ctx, "Upserted secret.")
+	}
+
+	return version, nil
+}
@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckAndSetSecret tests CheckAndSetSecret().
+func TestCheckAndSetSecret(t *testing.T) {
+	secretPath := "secret/checkandsetsecret"
+	err := vaultClient.PurgeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+
+	// Create a secret that must not exist.
+	version, err := vaultClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "one"}, "")
+	assert.NoError(t, err)
+	_, err = vaultClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "one"}, "")
+	assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+
+	// Update from the current version, then from a stale version.
+	_, err = vaultClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "two"}, version)
+	assert.NoError(t, err)
+	_, err = vaultClient.CheckAndSetSecret(ctx, secretPath, map[string]interface{}{"value": "three"}, version)
+	assert.ErrorIs(t, err, secretprovidertype.ErrConflict)
+}
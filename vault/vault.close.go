@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// Close stops renewing the Vault token and watched leases, revokes watched leases and stops monitoring the seal status.
+func (v *Vault) Close() (err error) {
+	v.closeOnce.Do(func() {
+		v.leaseLock.Lock()
+		close(v.done)
+		v.leaseLock.Unlock()
+		v.backgroundWaitGroup.Wait()
+
+		// Revoke leases.
+		ctx := context.WithValue(context.Background(), contexttype.SecretProviderID, v.ID) // nolint
+		err = v.revokeLeases(ctx)
+
+		// Log.
+		logger.Verbose(ctx, "Closed Vault client.")
+	})
+
+	return err
+}
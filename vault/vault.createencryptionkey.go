@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// CreateEncryptionKey creates a named key in the transit secrets engine.
+// An empty key type creates an aes256-gcm96 key.
+func (v *Vault) CreateEncryptionKey(ctx context.Context, name string, keyType string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	err := secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return err
+	}
+	if keyType == "" {
+		keyType = secretprovidertype.KeyTypeAES256GCM96
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Create key.
+	_, err = v.client.Logical().WriteWithContext(ctx, v.transitMount+"/keys/"+name, map[string]interface{}{
+		"type": keyType,
+	})
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	logger.Info(ctx, "Created encryption key.")
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// CreateNamespaceToken creates a token in a child namespace of the secret provider's namespace.
+// An empty namespace creates the token in the secret provider's namespace.
+func (v *Vault) CreateNamespaceToken(ctx context.Context, namespace string, options *secretprovidertype.TokenOptions) (token *secretprovidertype.Token, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if options == nil {
+		return nil, errors.New("options are required")
+	}
+	namespace = strings.Trim(namespace, "/")
+	for _, segment := range strings.Split(namespace, "/") {
+		if segment == ".." {
+			return nil, errors.New("namespace cannot traverse parent namespaces")
+		}
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Select namespace.
+	client := v.client
+	if namespace != "" {
+		if v.namespace != "" {
+			namespace = v.namespace + "/" + namespace
+		}
+		client = v.client.WithNamespace(namespace)
+	}
+
+	// Create token.
+	tokenCreateRequest := vault.TokenCreateRequest{
+		DisplayName:    options.DisplayName,
+		ExplicitMaxTTL: durationString(options.ExplicitMaxTTL),
+		ID:             options.ID,
+		Metadata:       options.Metadata,
+		NumUses:        options.NumUses,
+		Period:         durationString(options.Period),
+		Policies:       options.Policies,
+		Renewable:      options.Renewable,
+		TTL:            durationString(options.TTL),
+	}
+	var secret *vault.Secret
+	switch {
+	case options.Role != "":
+		secret, err = client.Auth().Token().CreateWithRoleWithContext(ctx, &tokenCreateRequest, options.Role)
+	case options.Orphan:
+		secret, err = client.Auth().Token().CreateOrphanWithContext(ctx, &tokenCreateRequest)
+	default:
+		secret, err = client.Auth().Token().CreateWithContext(ctx, &tokenCreateRequest)
+	}
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	token, err = toToken(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" && namespace != "" {
+		logger.Verbose(ctx, "Created token in namespace: "+namespace)
+	} else {
+		logger.Info(ctx, "Created token.")
+	}
+
+	return token, nil
+}
@@ -0,0 +1,27 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateNamespaceToken tests CreateNamespaceToken.
+func TestCreateNamespaceToken(t *testing.T) {
+	// Create token in the secret provider's namespace.
+	options := &secretprovidertype.TokenOptions{
+		DisplayName: "Test User",
+		ID:          "0e8b7c1f-6a41-4b1d-9f36-55c2d2a0c7b4",
+		NumUses:     1,
+		Policies:    []string{"default"},
+	}
+	accountToken, err := vaultClient.CreateNamespaceToken(ctx, "", options)
+	if assert.NoError(t, err) && assert.NotNil(t, accountToken) {
+		assert.Equal(t, options.ID, accountToken.ID)
+	}
+
+	// Reject namespaces outside of the secret provider's namespace.
+	_, err = vaultClient.CreateNamespaceToken(ctx, "../other", options)
+	assert.Error(t, err)
+}
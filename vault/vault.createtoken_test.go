@@ -2,20 +2,97 @@ package vault
 
 import (
 	"testing"
+	"time"
 
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
 	"github.com/stretchr/testify/assert"
 )
 
 // TestCreateToken tests CreateToken.
 func TestCreateToken(t *testing.T) {
 	// Create token for account.
-	accountID := "5c47ad37-7052-4e65-a0db-3ef2ac235e22"
-	displayName := "Test User"
-	numUses := 2
-	policies := []string{
-		"path \"secret/*\" { capabilities = [\"create\", \"read\", \"update\", \"delete\", \"list\"] }",
+	renewable := true
+	options := &secretprovidertype.TokenOptions{
+		DisplayName:    "Test User",
+		ExplicitMaxTTL: 2 * time.Hour,
+		ID:             "5c47ad37-7052-4e65-a0db-3ef2ac235e22",
+		Metadata:       map[string]string{"account": "test"},
+		NumUses:        2,
+		Policies: []string{
+			"path \"secret/*\" { capabilities = [\"create\", \"read\", \"update\", \"delete\", \"list\"] }",
+		},
+		Renewable: &renewable,
+		TTL:       time.Hour,
 	}
-	accountToken, err := vaultClient.CreateToken(ctx, accountID, displayName, numUses, policies)
-	assert.NotEqual(t, "", accountToken)
+	accountToken, err := vaultClient.CreateToken(ctx, options)
+	if !assert.NoError(t, err) || !assert.NotNil(t, accountToken) {
+		return
+	}
+	assert.Equal(t, options.ID, accountToken.ID)
+	assert.NotEmpty(t, accountToken.Accessor)
+	assert.Equal(t, "test", accountToken.Metadata["account"])
+	assert.True(t, accountToken.Renewable)
+	assert.NotNil(t, accountToken.Expiration)
+
+	// Look up token.
+	lookedUpToken, err := vaultClient.LookupToken(ctx, accountToken.ID)
+	if assert.NoError(t, err) && assert.NotNil(t, lookedUpToken) {
+		assert.Equal(t, accountToken.Accessor, lookedUpToken.Accessor)
+	}
+	lookedUpToken, err = vaultClient.LookupTokenAccessor(ctx, accountToken.Accessor)
+	if assert.NoError(t, err) && assert.NotNil(t, lookedUpToken) {
+		assert.Equal(t, "", lookedUpToken.ID)
+		assert.Equal(t, "test", lookedUpToken.Metadata["account"])
+	}
+
+	// Renew token.
+	renewedToken, err := vaultClient.RenewTokenAccessor(ctx, accountToken.Accessor, 30*time.Minute)
+	if assert.NoError(t, err) && assert.NotNil(t, renewedToken) {
+		assert.True(t, renewedToken.TTL > 0)
+	}
+
+	// Revoke token.
+	err = vaultClient.RevokeTokenAccessor(ctx, accountToken.Accessor)
 	assert.NoError(t, err)
+	_, err = vaultClient.LookupTokenAccessor(ctx, accountToken.Accessor)
+	assert.Error(t, err)
+
+	// Create orphan token.
+	orphanToken, err := vaultClient.CreateToken(ctx, &secretprovidertype.TokenOptions{
+		Orphan:   true,
+		Period:   time.Hour,
+		Policies: []string{"default"},
+	})
+	if assert.NoError(t, err) && assert.NotNil(t, orphanToken) {
+		assert.True(t, orphanToken.Orphan)
+		err = vaultClient.RevokeToken(ctx, orphanToken.ID)
+		assert.NoError(t, err)
+	}
+}
+
+// TestToToken tests converting Vault token secrets.
+func TestToToken(t *testing.T) {
+	token, err := toToken(&vault.Secret{
+		Auth: &vault.SecretAuth{
+			Accessor:      "accessor",
+			ClientToken:   "token",
+			LeaseDuration: 60,
+			Orphan:        true,
+			Policies:      []string{"default"},
+			Renewable:     true,
+		},
+	})
+	if assert.NoError(t, err) && assert.NotNil(t, token) {
+		assert.Equal(t, "accessor", token.Accessor)
+		assert.Equal(t, "token", token.ID)
+		assert.True(t, token.Orphan)
+		assert.True(t, token.Renewable)
+		assert.Equal(t, time.Minute, token.TTL)
+		assert.NotNil(t, token.Expiration)
+	}
+	_, err = toToken(nil)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+	assert.Equal(t, "90s", durationString(90*time.Second))
+	assert.Equal(t, "", durationString(0))
 }
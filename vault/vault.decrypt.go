@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Decrypt decrypts ciphertext with a named key in the transit secrets engine.
+func (v *Vault) Decrypt(ctx context.Context, name string, ciphertext string) (plaintext []byte, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return nil, err
+	}
+	if ciphertext == "" {
+		return nil, errors.New("ciphertext is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Decrypt.
+	data, err := v.writeTransit(ctx, "decrypt", name, map[string]interface{}{
+		"ciphertext": ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	encodedPlaintext, err := transitString(data, "plaintext")
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err = base64.StdEncoding.DecodeString(encodedPlaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Decrypted data.")
+
+	return plaintext, nil
+}
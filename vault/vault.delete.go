@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"os"
-	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
@@ -12,6 +11,7 @@ import (
 )
 
 // DeleteSecret deletes a secret.
+// On KV secrets engine version 2, only the current version is soft-deleted and can be restored with UndeleteSecretVersions; PurgeSecret removes every version.
 func (v *Vault) DeleteSecret(ctx context.Context, path string) error {
 	// Validate parameters.
 	if ctx == nil {
@@ -20,15 +20,16 @@ func (v *Vault) DeleteSecret(ctx context.Context, path string) error {
 	if path == "" {
 		return errors.New("path is required")
 	}
-	if !strings.HasPrefix(path, "secret/") {
-		path = "secret/" + path
+	path, err := v.secretPath(path)
+	if err != nil {
+		return err
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
 
 	// Delete secret.
-	_, err := v.client.Logical().Delete(path)
+	err = v.deleteKV(ctx, path)
 	if err != nil {
 		return err
 	}
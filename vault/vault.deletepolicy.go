@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// DeletePolicy deletes an ACL policy.
+func (v *Vault) DeletePolicy(ctx context.Context, name string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	err := secretprovidertype.ValidatePolicyName(name)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Delete policy.
+	err = v.client.Sys().DeletePolicyWithContext(ctx, name)
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Deleted policy: "+name)
+	} else {
+		logger.Info(ctx, "Deleted policy.")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteSecretVersions tests DeleteSecretVersions(), UndeleteSecretVersions() and DestroySecretVersions().
+func TestDeleteSecretVersions(t *testing.T) {
+	secretPath := "secret/deletesecretversions"
+	err := vaultClient.PurgeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	err = vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"value": "one"})
+	assert.NoError(t, err)
+
+	// Read the user payload.
+	secret, err := vaultClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, map[string]interface{}{"value": "one"}, secret.Data)
+		if assert.NotNil(t, secret.Metadata) {
+			assert.Equal(t, "1", secret.Metadata.Version)
+		}
+	}
+
+	// Soft-delete and restore the version.
+	err = vaultClient.DeleteSecretVersions(ctx, secretPath, []string{"1"})
+	assert.NoError(t, err)
+	_, err = vaultClient.ReadSecret(ctx, secretPath)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+	err = vaultClient.UndeleteSecretVersions(ctx, secretPath, []string{"1"})
+	assert.NoError(t, err)
+	_, err = vaultClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+
+	// Destroy the version.
+	err = vaultClient.DestroySecretVersions(ctx, secretPath, []string{"1"})
+	assert.NoError(t, err)
+	err = vaultClient.UndeleteSecretVersions(ctx, secretPath, []string{"1"})
+	assert.NoError(t, err)
+	_, err = vaultClient.ReadSecret(ctx, secretPath)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+
+	// Reject invalid versions.
+	err = vaultClient.DeleteSecretVersions(ctx, secretPath, nil)
+	assert.Error(t, err)
+}
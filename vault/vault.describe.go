@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// DescribeSecret returns a secret's metadata without reading its data.
+func (v *Vault) DescribeSecret(ctx context.Context, path string) (metadata *secretprovidertype.SecretMetadata, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	path, err = v.secretPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Read metadata.
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if version == 2 {
+		kvMetadata, err := v.client.KVv2(v.mount).GetMetadata(ctx, v.kvPath(path))
+		if err != nil {
+			return nil, wrapError(path, err)
+		}
+		metadata = toSecretMetadata(kvMetadata)
+	} else {
+		// KV version 1 does not track metadata, so only confirm that the secret exists.
+		_, err = v.readKV(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		metadata = new(secretprovidertype.SecretMetadata)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Described secret: "+path)
+	} else {
+		logger.Verbose(ctx, "Described secret.")
+	}
+
+	return metadata, nil
+}
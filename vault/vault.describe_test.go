@@ -0,0 +1,24 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDescribeSecret tests DescribeSecret().
+func TestDescribeSecret(t *testing.T) {
+	secretPath := "secret/describesecret"
+	err := vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+	assert.NoError(t, err)
+
+	// Describe existing secret.
+	metadata, err := vaultClient.DescribeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	assert.NotNil(t, metadata)
+
+	// Describe nonexistent secret.
+	_, err = vaultClient.DescribeSecret(ctx, "secret/nonexistent/path")
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
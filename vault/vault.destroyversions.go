@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+)
+
+// DestroySecretVersions permanently removes the data of versions of a secret.
+// Requires KV secrets engine version 2.
+func (v *Vault) DestroySecretVersions(ctx context.Context, path string, versions []string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	versionNumbers, err := parseVersions(versions)
+	if err != nil {
+		return err
+	}
+	path, err = v.secretPath(path)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Destroy secret versions.
+	err = v.requireKVv2(ctx, path)
+	if err != nil {
+		return err
+	}
+	err = v.client.KVv2(v.mount).Destroy(ctx, v.kvPath(path), versionNumbers)
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Destroyed secret versions: "+path)
+	} else {
+		logger.Info(ctx, "Destroyed secret versions.")
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Encrypt encrypts plaintext with a named key in the transit secrets engine.
+func (v *Vault) Encrypt(ctx context.Context, name string, plaintext []byte) (ciphertext string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return "", err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Encrypt.
+	data, err := v.writeTransit(ctx, "encrypt", name, map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	})
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err = transitString(data, "ciphertext")
+	if err != nil {
+		return "", err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Encrypted data.")
+
+	return ciphertext, nil
+}
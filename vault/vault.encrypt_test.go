@@ -0,0 +1,48 @@
+package vault
+
+import (
+	"strings"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEncrypt tests CreateEncryptionKey(), Encrypt(), Decrypt(), RotateEncryptionKey(), Rewrap(), Sign() and Verify().
+func TestEncrypt(t *testing.T) {
+	// Enable the transit secrets engine.
+	vaultClient.client.Sys().MountWithContext(ctx, defaultTransitMount, &vault.MountInput{Type: "transit"}) // nolint
+	err := vaultClient.CreateEncryptionKey(ctx, "testkey", "")
+	assert.NoError(t, err)
+
+	// Encrypt and decrypt.
+	ciphertext, err := vaultClient.Encrypt(ctx, "testkey", []byte("plaintext"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "vault:v1:"))
+	plaintext, err := vaultClient.Decrypt(ctx, "testkey", ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, "plaintext", string(plaintext))
+
+	// Rotate and rewrap.
+	err = vaultClient.RotateEncryptionKey(ctx, "testkey")
+	assert.NoError(t, err)
+	rewrapped, err := vaultClient.Rewrap(ctx, "testkey", ciphertext)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rewrapped, "vault:v2:"))
+	plaintext, err = vaultClient.Decrypt(ctx, "testkey", rewrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, "plaintext", string(plaintext))
+
+	// Sign and verify.
+	err = vaultClient.CreateEncryptionKey(ctx, "testsigningkey", secretprovidertype.KeyTypeED25519)
+	assert.NoError(t, err)
+	signature, err := vaultClient.Sign(ctx, "testsigningkey", []byte("input"))
+	assert.NoError(t, err)
+	valid, err := vaultClient.Verify(ctx, "testsigningkey", []byte("input"), signature)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = vaultClient.Verify(ctx, "testsigningkey", []byte("other input"), signature)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// wrapError classifies a Vault error using the shared secret provider errors.
+// Errors that cannot be classified are returned unchanged.
+func wrapError(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Classify Vault responses by status code.
+	var responseError *vault.ResponseError
+	if errors.As(err, &responseError) {
+		switch responseError.StatusCode {
+		case http.StatusBadRequest:
+			if responseErrorContains(responseError, "check-and-set") {
+				return secretprovidertype.NewError(secretprovidertype.ErrConflict, path, err)
+			}
+		case http.StatusForbidden:
+			return secretprovidertype.NewError(secretprovidertype.ErrPermissionDenied, path, err)
+		case http.StatusNotFound:
+			return secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, err)
+		case http.StatusServiceUnavailable:
+			if responseErrorContains(responseError, "sealed") {
+				return secretprovidertype.NewError(secretprovidertype.ErrSealed, path, err)
+			}
+
+			return secretprovidertype.NewError(secretprovidertype.ErrUnavailable, path, err)
+		case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+			return secretprovidertype.NewError(secretprovidertype.ErrUnavailable, path, err)
+		}
+
+		return err
+	}
+
+	// Classify client-side errors.
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, err)
+	}
+	var netError net.Error
+	var urlError *url.Error
+	if errors.As(err, &netError) || errors.As(err, &urlError) {
+		return secretprovidertype.NewError(secretprovidertype.ErrUnavailable, path, err)
+	}
+
+	return err
+}
+
+// responseErrorContains returns whether any error returned by Vault contains the specified text.
+func responseErrorContains(responseError *vault.ResponseError, text string) bool {
+	for _, message := range responseError.Errors {
+		if strings.Contains(strings.ToLower(message), text) {
+			return true
+		}
+	}
+
+	return false
+}
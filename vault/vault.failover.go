@@ -0,0 +1,201 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/bertjohnson/logger"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// pinnedAddressKey is the context key pinning a request to the Vault address with the given index.
+type pinnedAddressKey struct{}
+
+// failoverTransport sends requests to the selected Vault address, failing over to the other addresses on connection errors and unavailable responses.
+type failoverTransport struct {
+	addresses []*url.URL
+	current   int32
+	transport http.RoundTripper
+}
+
+// parseAddresses returns the Vault addresses of a secret provider, from URIs or a comma-separated URI.
+func parseAddresses(secretProvider *secretprovidertype.SecretProvider) ([]*url.URL, error) {
+	uris := secretProvider.URIs
+	if len(uris) == 0 {
+		uris = strings.Split(secretProvider.URI, ",")
+	}
+	addresses := make([]*url.URL, 0, len(uris))
+	for _, uri := range uris {
+		uri = strings.TrimSpace(uri)
+		if uri == "" {
+			continue
+		}
+		address, err := url.Parse(uri)
+		if err != nil || address.Host == "" {
+			return nil, errors.New("Vault address is invalid: " + uri)
+		}
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// RoundTrip sends a request to the selected Vault address, failing over to the others if it cannot serve the request.
+// Redirects, such as from standby nodes to the active node, are followed here rather than by the Vault client, so every request is an original request.
+func (t *failoverTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// Buffer the body so that the request can be sent again.
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close() // nolint
+		if err != nil {
+			return nil, err
+		}
+	}
+	if index, ok := request.Context().Value(pinnedAddressKey{}).(int); ok {
+		response, _, err := t.send(request, index, body)
+
+		return response, err
+	}
+
+	// Try each address, starting with the selected one.
+	start := int(atomic.LoadInt32(&t.current))
+	var (
+		err      error
+		response *http.Response
+	)
+	for i := 0; i < len(t.addresses); i++ {
+		index := (start + i) % len(t.addresses)
+		var servedIndex int
+		response, servedIndex, err = t.send(request, index, body)
+		if err == nil && response.StatusCode != http.StatusServiceUnavailable {
+			if servedIndex >= 0 && servedIndex != start {
+				atomic.StoreInt32(&t.current, int32(servedIndex))
+				if servedIndex == index {
+					logger.Warn(request.Context(), "Failed over to Vault address: "+t.addresses[servedIndex].String())
+				} else {
+					logger.Verbose(request.Context(), "Redirected to Vault address: "+t.addresses[servedIndex].String())
+				}
+			}
+
+			return response, nil
+		}
+		if request.Context().Err() != nil {
+			break
+		}
+		if response != nil && i < len(t.addresses)-1 {
+			io.Copy(ioutil.Discard, response.Body) // nolint
+			response.Body.Close()                  // nolint
+		}
+	}
+
+	return response, err
+}
+
+// send sends a request to the Vault address with the given index, following a single redirect.
+// It returns the index of the Vault address that served the request, or -1 if it was redirected elsewhere.
+func (t *failoverTransport) send(request *http.Request, index int, body []byte) (*http.Response, int, error) {
+	response, err := t.transport.RoundTrip(t.rewrite(request, index, body))
+	if err != nil {
+		return nil, index, err
+	}
+	switch response.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect:
+	default:
+		return response, index, nil
+	}
+
+	// Follow redirect.
+	location, err := response.Location()
+	io.Copy(ioutil.Discard, response.Body) // nolint
+	response.Body.Close()                  // nolint
+	if err != nil {
+		return nil, index, err
+	}
+	if t.addresses[index].Scheme == "https" && location.Scheme != "https" {
+		return nil, index, errors.New("redirect would cause protocol downgrade")
+	}
+	redirected := request.Clone(request.Context())
+	redirected.URL = location
+	redirected.Host = location.Host
+	if body != nil {
+		redirected.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	response, err = t.transport.RoundTrip(redirected)
+
+	return response, t.indexOf(location.Host), err
+}
+
+// rewrite returns a copy of a request sent to the Vault address with the given index.
+func (t *failoverTransport) rewrite(request *http.Request, index int, body []byte) *http.Request {
+	address := t.addresses[index]
+	rewritten := request.Clone(request.Context())
+	rewritten.URL.Scheme = address.Scheme
+	rewritten.URL.Host = address.Host
+	rewritten.Host = address.Host
+	if body != nil {
+		rewritten.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	return rewritten
+}
+
+// indexOf returns the index of the Vault address with a host, or -1 if there is none.
+func (t *failoverTransport) indexOf(host string) int {
+	for index, address := range t.addresses {
+		if address.Host == host {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// nodeContexts returns a context pinned to each Vault address.
+// If there is a single address, ctx is returned unchanged.
+func (v *Vault) nodeContexts(ctx context.Context) []context.Context {
+	if v.failover == nil {
+		return []context.Context{ctx}
+	}
+	nodeContexts := make([]context.Context, len(v.failover.addresses))
+	for index := range v.failover.addresses {
+		nodeContexts[index] = context.WithValue(ctx, pinnedAddressKey{}, index)
+	}
+
+	return nodeContexts
+}
+
+// selectAddress selects the active Vault node, falling back to an unsealed standby node.
+func (v *Vault) selectAddress(ctx context.Context) {
+	if v.failover == nil {
+		return
+	}
+	standby := -1
+	for index, nodeContext := range v.nodeContexts(ctx) {
+		health, err := v.sysClient.Sys().HealthWithContext(nodeContext)
+		if err != nil || health.Sealed || !health.Initialized {
+			continue
+		}
+		if !health.Standby && !health.PerformanceStandby {
+			atomic.StoreInt32(&v.failover.current, int32(index))
+			logger.Verbose(ctx, "Selected active Vault address: "+v.failover.addresses[index].String())
+
+			return
+		}
+		if standby < 0 {
+			standby = index
+		}
+	}
+	if standby >= 0 {
+		atomic.StoreInt32(&v.failover.current, int32(standby))
+		logger.Verbose(ctx, "Selected standby Vault address: "+v.failover.addresses[standby].String())
+	}
+}
@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFailoverTransport tests failing over between Vault addresses.
+func TestFailoverTransport(t *testing.T) {
+	// Run a sealed node, an unreachable node and an active node.
+	sealed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer sealed.Close()
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+	active := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body) // nolint
+		w.Write(body)                     // nolint
+	}))
+	defer active.Close()
+	addresses, err := parseAddresses(&secretprovidertype.SecretProvider{URI: sealed.URL + ", " + unreachable.URL + "," + active.URL})
+	assert.NoError(t, err)
+	assert.Len(t, addresses, 3)
+	transport := &failoverTransport{
+		addresses: addresses,
+		transport: http.DefaultTransport,
+	}
+	client := &http.Client{Transport: transport}
+
+	// Fail over to the active node, resending the body.
+	response, err := client.Post(sealed.URL+"/v1/secret/data/test", "application/json", strings.NewReader(`{"a":"b"}`))
+	if assert.NoError(t, err) {
+		body, _ := ioutil.ReadAll(response.Body) // nolint
+		response.Body.Close()                    // nolint
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, `{"a":"b"}`, string(body))
+	}
+	assert.Equal(t, int32(2), transport.current)
+
+	// Pin requests to a node.
+	request, err := http.NewRequestWithContext(context.WithValue(ctx, pinnedAddressKey{}, 0), http.MethodGet, sealed.URL+"/v1/sys/health", nil)
+	assert.NoError(t, err)
+	response, err = client.Do(request)
+	if assert.NoError(t, err) {
+		response.Body.Close() // nolint
+		assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	}
+
+	// Follow redirects from a standby node to the active node, even when it is the first address.
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, active.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	defer standby.Close()
+	addresses, err = parseAddresses(&secretprovidertype.SecretProvider{URIs: []string{active.URL, standby.URL}})
+	assert.NoError(t, err)
+	transport = &failoverTransport{
+		addresses: addresses,
+		current:   1,
+		transport: http.DefaultTransport,
+	}
+	client = &http.Client{Transport: transport}
+	response, err = client.Post(active.URL+"/v1/secret/data/test", "application/json", strings.NewReader(`{"a":"b"}`))
+	if assert.NoError(t, err) {
+		body, _ := ioutil.ReadAll(response.Body) // nolint
+		response.Body.Close()                    // nolint
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, `{"a":"b"}`, string(body))
+	}
+	assert.Equal(t, int32(0), transport.current)
+
+	// Reject invalid addresses.
+	_, err = parseAddresses(&secretprovidertype.SecretProvider{URIs: []string{"not an address"}})
+	assert.Error(t, err)
+}
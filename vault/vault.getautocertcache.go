@@ -11,21 +11,20 @@ import (
 	contexttype "github.com/bertjohnson/logger/types/context"
 	"github.com/bertjohnson/logger/types/env"
 	secretprovidertype "github.com/bertjohnson/secretprovider/types"
-	vault "github.com/hashicorp/vault/api"
 	"golang.org/x/crypto/acme/autocert"
 )
 
 // AutoCertCache implements AutoCertCache using Vault.
 type AutoCertCache struct {
-	client *vault.Client
-	ID     string
+	ID    string
+	vault *Vault
 }
 
 // GetAutoCertCache returns an autocert-compatible cache.
 func (v *Vault) GetAutoCertCache(ctx context.Context) secretprovidertype.AutoCertCache {
 	return AutoCertCache{
-		client: v.client,
-		ID:     v.ID,
+		ID:    v.ID,
+		vault: v,
 	}
 }
 
@@ -39,19 +38,19 @@ func (a AutoCertCache) Get(ctx context.Context, name string) ([]byte, error) {
 		return nil, errors.New("name is required")
 	}
 	if strings.Contains(name, "/") {
-		return nil, errors.New("name cannot traverse directories")
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
 
 	// Read secret.
-	path := "secret/autocert/" + name
-	vaultSecret, err := a.client.Logical().Read(path)
-	if err != nil {
+	path := a.vault.mount + "/autocert/" + name
+	kvSecret, err := a.vault.readKV(ctx, path)
+	if err != nil && !errors.Is(err, secretprovidertype.ErrNotFound) {
 		return nil, err
 	}
-	if vaultSecret == nil {
+	if err != nil {
 		if os.Getenv(env.Debug) != "" {
 			logger.Verbose(ctx, "Secret does not exist: "+path)
 		} else {
@@ -62,7 +61,7 @@ func (a AutoCertCache) Get(ctx context.Context, name string) ([]byte, error) {
 	}
 
 	// Parse secret.
-	secretString, ok := vaultSecret.Data["cert"].(string)
+	secretString, ok := kvSecret.Data["cert"].(string)
 	if !ok {
 		if os.Getenv(env.Debug) != "" {
 			logger.Verbose(ctx, "Secret does not contain 'cert': "+path)
@@ -99,18 +98,18 @@ func (a AutoCertCache) Put(ctx context.Context, name string, data []byte) error
 		return errors.New("name is required")
 	}
 	if strings.Contains(name, "/") {
-		return errors.New("name cannot traverse directories")
+		return secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
 
 	// Create secret.
-	path := "secret/autocert/" + name
+	path := a.vault.mount + "/autocert/" + name
 	secretData := map[string]interface{}{
 		"cert": data,
 	}
-	_, err := a.client.Logical().Write(path, secretData)
+	err := a.vault.writeKV(ctx, path, secretData)
 	if err != nil {
 		return err
 	}
@@ -135,15 +134,15 @@ func (a AutoCertCache) Delete(ctx context.Context, name string) error {
 		return errors.New("name is required")
 	}
 	if strings.Contains(name, "/") {
-		return errors.New("name cannot traverse directories")
+		return secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, name, errors.New("name cannot traverse directories"))
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, a.ID) // nolint
 
 	// Delete secret.
-	path := "secret/autocert/" + name
-	_, err := a.client.Logical().Delete(path)
+	path := a.vault.mount + "/autocert/" + name
+	err := a.vault.deleteKV(ctx, path)
 	if err != nil {
 		return err
 	}
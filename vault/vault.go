@@ -5,8 +5,9 @@ import (
 	"context"
 	"errors"
 	"os"
-	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
@@ -15,12 +16,61 @@ import (
 	vault "github.com/hashicorp/vault/api"
 )
 
+const (
+	// Environment variables configuring Vault.
+	envVaultAuthMethod    = "VAULT_AUTH_METHOD"
+	envVaultAuthMount     = "VAULT_AUTH_MOUNT"
+	envVaultAuthRole      = "VAULT_AUTH_ROLE"
+	envVaultAuthTokenFile = "VAULT_AUTH_TOKEN_FILE"
+	envVaultMount         = "VAULT_MOUNT"
+	envVaultPathPrefix    = "VAULT_PATH_PREFIX"
+	envVaultPKIMount      = "VAULT_PKI_MOUNT"
+	envVaultPKIRole       = "VAULT_PKI_ROLE"
+	envVaultTransitMount  = "VAULT_TRANSIT_MOUNT"
+)
+
 // Vault provides methods for interacting with Vault.
 type Vault struct {
 	ID string
 
-	client      *vault.Client
-	vaultConfig *vault.Config
+	authenticate        authenticator
+	authLock            sync.Mutex
+	authSecret          *vault.Secret
+	backgroundWaitGroup sync.WaitGroup
+	client              *vault.Client
+	closeOnce           sync.Once
+	done                chan struct{}
+	failover            *failoverTransport
+	leaseLock           sync.Mutex
+	leases              map[string]*watchedLease
+	kvVersionLock       sync.Mutex
+	loginClient         *vault.Client
+	renewalErr          error
+	sealSignal          chan struct{}
+	sysClient           *vault.Client
+	tokenExpiration     time.Time
+	tokenFile           string
+	tokenFileTime       time.Time
+	transitMount        string
+	mount               string
+	mountVersion        int
+	namespace           string
+	pathPrefix          string
+	pkiMount            string
+	pkiRole             string
+	vaultConfig         *vault.Config
+}
+
+// init registers the Vault secret provider type.
+func init() {
+	secretprovidertype.Register("vault", func(ctx context.Context, secretProvider *secretprovidertype.SecretProvider) (secretprovidertype.ISecretProvider, error) {
+		vaultClient, err := New(ctx, secretProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return vaultClient, nil
+	})
 }
 
 // New creates a matching secret store implementation.
@@ -40,15 +90,34 @@ func New(ctx context.Context, secretProvider *secretprovidertype.SecretProvider)
 	logger.Verbose(ctx, "Creating Vault client.")
 
 	// Inherit defaults.
+	if secretProvider.AuthMethod == "" {
+		secretProvider.AuthMethod = os.Getenv(envVaultAuthMethod)
+		if secretProvider.AuthMethod == "" {
+			secretProvider.AuthMethod = authMethodToken
+		}
+	}
+	if secretProvider.AuthMount == "" {
+		secretProvider.AuthMount = os.Getenv(envVaultAuthMount)
+	}
+	if secretProvider.AuthRole == "" {
+		secretProvider.AuthRole = os.Getenv(envVaultAuthRole)
+	}
+	if secretProvider.AuthTokenFile == "" {
+		secretProvider.AuthTokenFile = os.Getenv(envVaultAuthTokenFile)
+	}
+	if secretProvider.ClientID == "" {
+		secretProvider.ClientID = os.Getenv(env.SecretProviderClientID)
+	}
 	if secretProvider.ClientSecret == "" {
 		secretProvider.ClientSecret = os.Getenv(env.SecretProviderClientSecret)
-		if secretProvider.ClientSecret == "" {
+		if secretProvider.ClientSecret == "" && strings.EqualFold(secretProvider.AuthMethod, authMethodToken) {
 			secretProvider.ClientSecret = os.Getenv(env.VaultToken)
-			if secretProvider.ClientSecret == "" {
-				return nil, errors.New("Vault token is required")
-			}
 		}
 	}
+	authenticate, err := newAuthenticator(secretProvider)
+	if err != nil {
+		return nil, err
+	}
 	if len(secretProvider.UnsealShards) == 0 {
 		unsealShards := os.Getenv(env.VaultUnsealShards)
 		if unsealShards != "" {
@@ -61,65 +130,138 @@ func New(ctx context.Context, secretProvider *secretprovidertype.SecretProvider)
 			}
 		}
 	}
-	if secretProvider.URI == "" {
-		secretProvider.URI = os.Getenv(env.VaultAddr)
+	if secretProvider.Mount == "" {
+		secretProvider.Mount = os.Getenv(envVaultMount)
+		if secretProvider.Mount == "" {
+			secretProvider.Mount = defaultMount
+		}
 	}
-
-	// Initialize Vault client.
-	vaultConfig := vault.DefaultConfig()
-	vaultConfig.Address = secretProvider.URI
-
-	// Configure TLS.
-	skipVerify := strings.ToLower(os.Getenv(env.VaultSkipVerify))
-	if boolVal, _ := strconv.ParseBool(skipVerify); boolVal { // nolint
-		vaultTLSConfig := vault.TLSConfig{
-			Insecure: true,
+	if secretProvider.Namespace == "" {
+		secretProvider.Namespace = os.Getenv(vault.EnvVaultNamespace)
+	}
+	if secretProvider.PathPrefix == "" {
+		secretProvider.PathPrefix = os.Getenv(envVaultPathPrefix)
+	}
+	if secretProvider.PKIMount == "" {
+		secretProvider.PKIMount = os.Getenv(envVaultPKIMount)
+		if secretProvider.PKIMount == "" {
+			secretProvider.PKIMount = defaultPKIMount
 		}
-		logger.Info(ctx, "Skipping Vault TLS certificate verification.")
-		err := vaultConfig.ConfigureTLS(&vaultTLSConfig)
-		if err != nil {
-			return nil, err
+	}
+	if secretProvider.PKIRole == "" {
+		secretProvider.PKIRole = os.Getenv(envVaultPKIRole)
+	}
+	if secretProvider.TransitMount == "" {
+		secretProvider.TransitMount = os.Getenv(envVaultTransitMount)
+		if secretProvider.TransitMount == "" {
+			secretProvider.TransitMount = defaultTransitMount
 		}
 	}
+	mount := strings.Trim(secretProvider.Mount, "/")
+	pathPrefix := strings.Trim(secretProvider.PathPrefix, "/")
+	if mount == "" {
+		return nil, errors.New("Vault mount is invalid: " + secretProvider.Mount)
+	}
+	if pathPrefix != "" {
+		pathPrefix += "/"
+	}
+
+	// Initialize Vault client.
+	vaultConfig, failover, err := newConfig(ctx, secretProvider)
+	if err != nil {
+		return nil, err
+	}
 
 	// Wait until the shard is unsealed.
-	var err error
 	vaultClient := Vault{
-		ID:          secretProvider.ID,
-		vaultConfig: vaultConfig,
+		ID:           secretProvider.ID,
+		done:         make(chan struct{}),
+		failover:     failover,
+		leases:       make(map[string]*watchedLease),
+		mount:        mount,
+		pathPrefix:   pathPrefix,
+		sealSignal:   make(chan struct{}, 1),
+		pkiMount:     strings.Trim(secretProvider.PKIMount, "/"),
+		pkiRole:      secretProvider.PKIRole,
+		transitMount: strings.Trim(secretProvider.TransitMount, "/"),
+		vaultConfig:  vaultConfig,
 	}
 	logger.Info(ctx, "Unsealing Vault.")
-	vaultClient.client, err = vault.NewClient(vaultClient.vaultConfig)
+	vaultClient.loginClient, err = vault.NewClient(vaultClient.vaultConfig)
 	if err != nil {
 		return nil, err
 	}
-	var resp *vault.SealStatusResponse
-	for _, unsealShard := range secretProvider.UnsealShards {
-		if os.Getenv(env.Debug) != "" {
-			logger.Verbose(ctx, "Unsealing using shard: "+unsealShard)
-		}
-		resp, err = vaultClient.client.Sys().Unseal(unsealShard)
-		if err != nil {
-			return nil, err
-		}
+	vaultClient.client = vaultClient.loginClient.WithRequestCallbacks(vaultClient.refreshToken).WithResponseCallbacks(vaultClient.detectSeal)
+
+	// Seal, unseal and health endpoints are only served in the root namespace.
+	vaultClient.sysClient = vaultClient.loginClient.WithNamespace("")
+	err = vaultClient.unseal(ctx, secretProvider.UnsealShards)
+	if err != nil {
+		return nil, err
+	}
+	vaultClient.selectAddress(ctx)
+	logger.Info(ctx, "Unsealed Vault.")
+
+	// Set namespace.
+	vaultClient.namespace = strings.Trim(secretProvider.Namespace, "/")
+	if vaultClient.namespace != "" {
+		vaultClient.loginClient.SetNamespace(vaultClient.namespace)
+		vaultClient.client.SetNamespace(vaultClient.namespace)
 	}
-	if resp == nil {
-		resp, err = vaultClient.client.Sys().SealStatus()
+
+	// Authenticate.
+	if authenticate == nil {
+		vaultClient.client.SetToken(secretProvider.ClientSecret)
+	} else {
+		vaultClient.authenticate = authenticate
+		vaultClient.tokenFile = tokenFile(secretProvider)
+		err = vaultClient.login(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if resp.Sealed {
-		return nil, errors.New("Vault is sealed")
-	}
-
-	logger.Info(ctx, "Unsealed Vault.")
 
-	// Set the worker token.
-	vaultClient.client.SetToken(secretProvider.ClientSecret)
+	// Renew token and monitor the seal status.
+	vaultClient.startRenewal(ctx)
+	vaultClient.startSealMonitor(secretProvider.UnsealShards)
 
 	// Log.
 	logger.Verbose(ctx, "Created Vault client.")
 
 	return &vaultClient, nil
 }
+
+// newConfig creates the Vault client configuration, including TLS and failover between addresses.
+func newConfig(ctx context.Context, secretProvider *secretprovidertype.SecretProvider) (*vault.Config, *failoverTransport, error) {
+	if secretProvider.URI == "" {
+		secretProvider.URI = os.Getenv(env.VaultAddr)
+	}
+	vaultConfig := vault.DefaultConfig()
+	addresses, err := parseAddresses(secretProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+	vaultConfig.Address = secretProvider.URI
+	if len(addresses) > 0 {
+		vaultConfig.Address = addresses[0].String()
+	}
+
+	// Configure TLS.
+	err = configureTLS(ctx, vaultConfig, secretProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fail over between addresses.
+	var failover *failoverTransport
+	if len(addresses) > 1 {
+		failover = &failoverTransport{
+			addresses: addresses,
+			transport: vaultConfig.HttpClient.Transport,
+		}
+		vaultConfig.HttpClient.Transport = failover
+		vaultConfig.DisableRedirects = true
+	}
+
+	return vaultConfig, failover, nil
+}
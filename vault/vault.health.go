@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Health reports whether Vault is reachable and unsealed.
+func (v *Vault) Health(ctx context.Context) (health *secretprovidertype.Health, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Check seal status.
+	health = &secretprovidertype.Health{
+		Checked: time.Now(),
+	}
+	status, err := v.sysClient.Sys().SealStatusWithContext(ctx)
+	if err != nil {
+		health.Error = wrapError("", err).Error()
+
+		return health, nil
+	}
+	if status.Sealed {
+		health.Error = "Vault is sealed"
+		health.Sealed = true
+
+		return health, nil
+	}
+	if renewalErr := v.RenewalError(); renewalErr != nil {
+		health.Error = renewalErr.Error()
+
+		return health, nil
+	}
+	health.Healthy = true
+
+	return health, nil
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHealth tests Health().
+func TestHealth(t *testing.T) {
+	health, err := vaultClient.Health(ctx)
+	assert.NoError(t, err)
+	if assert.NotNil(t, health) {
+		assert.True(t, health.Healthy)
+		assert.False(t, health.Sealed)
+		assert.Empty(t, health.Error)
+	}
+}
+
+// TestDetectSeal tests that unavailable responses trigger a seal check without blocking.
+func TestDetectSeal(t *testing.T) {
+	v := &Vault{sealSignal: make(chan struct{}, 1)}
+	v.detectSeal(&vault.Response{Response: &http.Response{StatusCode: http.StatusOK}})
+	assert.Len(t, v.sealSignal, 0)
+	v.detectSeal(&vault.Response{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}})
+	v.detectSeal(&vault.Response{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}})
+	assert.Len(t, v.sealSignal, 1)
+}
+
+// TestHealthNamespace tests that seal and health checks are sent to the root namespace when a namespace is configured.
+func TestHealthNamespace(t *testing.T) {
+	// Run a node recording the namespaces of requests.
+	var (
+		lock       sync.Mutex
+		namespaces = make(map[string]string)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		namespaces[r.URL.Path] = r.Header.Get("X-Vault-Namespace")
+		lock.Unlock()
+		if strings.HasPrefix(r.URL.Path, "/v1/sys/") && r.Header.Get("X-Vault-Namespace") != "" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"initialized": true, "sealed": false}) // nolint
+	}))
+	defer server.Close()
+	v, err := New(ctx, &secretprovidertype.SecretProvider{
+		ClientSecret: "token",
+		Namespace:    "team",
+		URI:          server.URL,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer v.Close()
+
+	// Check health.
+	health, err := v.Health(ctx)
+	if assert.NoError(t, err) && assert.NotNil(t, health) {
+		assert.True(t, health.Healthy)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, "", namespaces["/v1/sys/seal-status"])
+	assert.Equal(t, "team", namespaces["/v1/auth/token/lookup-self"])
+}
@@ -0,0 +1,155 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Default path at which unseal shards are stored by Initialize.
+const defaultShardPath = "vault/unsealshard"
+
+// InitOptions configures the initialization of Vault.
+// Vaults sealed by an HSM or KMS (auto-unseal) generate recovery shards instead of unseal shards.
+type InitOptions struct {
+	RecoveryShares    int                                  // Number of recovery shards to generate, for auto-unseal seals.
+	RecoveryThreshold int                                  // Number of recovery shards required for recovery operations, for auto-unseal seals.
+	SecretShares      int                                  // Number of unseal shards to generate.
+	SecretThreshold   int                                  // Number of unseal shards required to unseal Vault.
+	ShardPath         string                               // Path at which each unseal or recovery shard is stored, if shard stores are configured.
+	ShardStores       []secretprovidertype.ISecretProvider // Optional secret providers to store the unseal shards, or recovery shards for auto-unseal seals, in, one shard per secret provider.
+}
+
+// InitResult contains the credentials generated when initializing Vault.
+type InitResult struct {
+	RecoveryShards []string // Recovery shards, hex encoded, for auto-unseal seals.
+	RootToken      string   // Initial root token.
+	UnsealShards   []string // Unseal shards, hex encoded.
+}
+
+// Initialize initializes an uninitialized Vault, returning its unseal shards and root token.
+// If shard stores are configured, each unseal shard is also stored in a different secret provider so that no single secret provider can unseal Vault.
+func Initialize(ctx context.Context, secretProvider *secretprovidertype.SecretProvider, options *InitOptions) (*InitResult, error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if secretProvider == nil {
+		return nil, errors.New("secret store configuration is required")
+	}
+	if options == nil {
+		return nil, errors.New("options are required")
+	}
+	shares, threshold := options.SecretShares, options.SecretThreshold
+	if options.RecoveryShares > 0 {
+		if options.RecoveryThreshold < 1 || options.RecoveryThreshold > options.RecoveryShares {
+			return nil, errors.New("recovery threshold must be between 1 and the number of recovery shares")
+		}
+		shares, threshold = options.RecoveryShares, options.RecoveryThreshold
+	} else {
+		if options.SecretShares < 1 {
+			return nil, errors.New("secret shares must be at least 1")
+		}
+		if options.SecretThreshold < 1 || options.SecretThreshold > options.SecretShares {
+			return nil, errors.New("secret threshold must be between 1 and the number of secret shares")
+		}
+	}
+	if len(options.ShardStores) > 0 {
+		if len(options.ShardStores) != shares {
+			return nil, errors.New("one shard store is required per share")
+		}
+		if threshold < 2 {
+			return nil, errors.New("threshold must be at least 2 when storing shards, so that no single shard store can unseal or recover Vault")
+		}
+		for i, shardStore := range options.ShardStores {
+			if shardStore == nil {
+				return nil, errors.New("shard store " + strconv.Itoa(i) + " is required")
+			}
+		}
+	}
+	if strings.Trim(secretProvider.Namespace, "/") != "" {
+		return nil, errors.New("Vault must be initialized outside of a namespace")
+	}
+	shardPath := options.ShardPath
+	if shardPath == "" {
+		shardPath = defaultShardPath
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, secretProvider.ID) // nolint
+
+	// Log.
+	logger.Verbose(ctx, "Initializing Vault.")
+
+	// Initialize Vault client.
+	vaultConfig, _, err := newConfig(ctx, secretProvider)
+	if err != nil {
+		return nil, err
+	}
+	client, err := vault.NewClient(vaultConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check whether Vault is already initialized.
+	initialized, err := client.Sys().InitStatusWithContext(ctx)
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	if initialized {
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrAlreadyExists, "", errors.New("Vault is already initialized"))
+	}
+
+	// Initialize Vault.
+	initResponse, err := client.Sys().InitWithContext(ctx, &vault.InitRequest{
+		RecoveryShares:    options.RecoveryShares,
+		RecoveryThreshold: options.RecoveryThreshold,
+		SecretShares:      options.SecretShares,
+		SecretThreshold:   options.SecretThreshold,
+	})
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	result := &InitResult{
+		RecoveryShards: initResponse.RecoveryKeys,
+		RootToken:      initResponse.RootToken,
+		UnsealShards:   initResponse.Keys,
+	}
+
+	// Store unseal shards, or recovery shards if Vault is sealed by an HSM or KMS.
+	if len(options.ShardStores) == 0 {
+		logger.Info(ctx, "Initialized Vault.")
+
+		return result, nil
+	}
+	shards := result.UnsealShards
+	if len(shards) == 0 {
+		shards = result.RecoveryShards
+	}
+	if len(shards) != len(options.ShardStores) {
+		// Return the result regardless, since Vault cannot be initialized again.
+		return result, errors.New("Vault returned " + strconv.Itoa(len(shards)) + " shards for " + strconv.Itoa(len(options.ShardStores)) + " shard stores")
+	}
+	for i, shardStore := range options.ShardStores {
+		err = shardStore.UpsertSecret(ctx, shardPath, map[string]interface{}{
+			"index":     i + 1,
+			"shard":     shards[i],
+			"threshold": threshold,
+		})
+		if err != nil {
+			// Return the result regardless, since Vault cannot be initialized again.
+			return result, errors.New("unable to store unseal shard " + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+	}
+
+	// Log.
+	logger.Info(ctx, "Initialized Vault.")
+
+	return result, nil
+}
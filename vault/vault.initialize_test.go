@@ -0,0 +1,130 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bertjohnson/secretprovider/localfiles"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInitialize tests Initialize().
+func TestInitialize(t *testing.T) {
+	// Run an uninitialized node.
+	initialized := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/init" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(map[string]interface{}{"initialized": initialized}) // nolint
+
+			return
+		}
+		var request map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&request) // nolint
+		assert.Equal(t, float64(3), request["secret_shares"])
+		assert.Equal(t, float64(2), request["secret_threshold"])
+		initialized = true
+		json.NewEncoder(w).Encode(map[string]interface{}{ // nolint
+			"keys":       []string{"shard1", "shard2", "shard3"},
+			"root_token": "roottoken",
+		})
+	}))
+	defer server.Close()
+
+	// Create shard stores.
+	shardStores := make([]secretprovidertype.ISecretProvider, 3)
+	for i := range shardStores {
+		shardStore, err := localfiles.New(ctx, &secretprovidertype.SecretProvider{URI: t.TempDir()})
+		if !assert.NoError(t, err) {
+			return
+		}
+		shardStores[i] = shardStore
+	}
+
+	// Reject a quorum in a single shard store.
+	secretProvider := &secretprovidertype.SecretProvider{URI: server.URL}
+	_, err := Initialize(ctx, secretProvider, &InitOptions{SecretShares: 3, SecretThreshold: 2, ShardStores: shardStores[:1]})
+	assert.Error(t, err)
+	_, err = Initialize(ctx, secretProvider, &InitOptions{SecretShares: 3, SecretThreshold: 1, ShardStores: shardStores})
+	assert.Error(t, err)
+
+	// Initialize.
+	result, err := Initialize(ctx, secretProvider, &InitOptions{SecretShares: 3, SecretThreshold: 2, ShardStores: shardStores})
+	if assert.NoError(t, err) && assert.NotNil(t, result) {
+		assert.Equal(t, "roottoken", result.RootToken)
+		assert.Equal(t, []string{"shard1", "shard2", "shard3"}, result.UnsealShards)
+	}
+	for i, shardStore := range shardStores {
+		secret, err := shardStore.ReadSecret(ctx, defaultShardPath)
+		if assert.NoError(t, err) && assert.NotNil(t, secret) {
+			assert.Equal(t, result.UnsealShards[i], secret.Data["shard"])
+		}
+	}
+
+	// Reject initializing twice.
+	_, err = Initialize(ctx, secretProvider, &InitOptions{SecretShares: 3, SecretThreshold: 2})
+	assert.ErrorIs(t, err, secretprovidertype.ErrAlreadyExists)
+}
+
+// TestInitializeAutoUnseal tests Initialize() with a Vault sealed by an HSM or KMS.
+func TestInitializeAutoUnseal(t *testing.T) {
+	// Run an uninitialized node returning recovery shards.
+	recoveryKeys := []string{"recovery1", "recovery2", "recovery3"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/init" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(map[string]interface{}{"initialized": false}) // nolint
+
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{ // nolint
+			"keys":          []string{},
+			"recovery_keys": recoveryKeys,
+			"root_token":    "roottoken",
+		})
+	}))
+	defer server.Close()
+
+	// Create shard stores.
+	shardStores := make([]secretprovidertype.ISecretProvider, 3)
+	for i := range shardStores {
+		shardStore, err := localfiles.New(ctx, &secretprovidertype.SecretProvider{URI: t.TempDir()})
+		if !assert.NoError(t, err) {
+			return
+		}
+		shardStores[i] = shardStore
+	}
+
+	// Store recovery shards.
+	secretProvider := &secretprovidertype.SecretProvider{URI: server.URL}
+	result, err := Initialize(ctx, secretProvider, &InitOptions{RecoveryShares: 3, RecoveryThreshold: 2, ShardStores: shardStores})
+	if assert.NoError(t, err) && assert.NotNil(t, result) {
+		assert.Equal(t, recoveryKeys, result.RecoveryShards)
+		assert.Empty(t, result.UnsealShards)
+	}
+	for i, shardStore := range shardStores {
+		secret, err := shardStore.ReadSecret(ctx, defaultShardPath)
+		if assert.NoError(t, err) && assert.NotNil(t, secret) {
+			assert.Equal(t, recoveryKeys[i], secret.Data["shard"])
+		}
+	}
+
+	// Report fewer shards than shard stores rather than storing them.
+	recoveryKeys = recoveryKeys[:2]
+	result, err = Initialize(ctx, secretProvider, &InitOptions{RecoveryShares: 3, RecoveryThreshold: 2, ShardStores: shardStores})
+	assert.Error(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "roottoken", result.RootToken)
+	}
+}
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+)
+
+// IssueCertificate issues a certificate and private key from the PKI secrets engine.
+func (v *Vault) IssueCertificate(ctx context.Context, request *CertificateRequest) (certificate *Certificate, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if request == nil {
+		return nil, errors.New("certificate request is required")
+	}
+	if request.CommonName == "" {
+		return nil, errors.New("common name is required")
+	}
+	role, err := v.certificateRole(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Issue certificate.
+	secret, err := v.client.Logical().WriteWithContext(ctx, v.pkiMount+"/issue/"+role, certificateRequestData(request))
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	if secret == nil {
+		return nil, errors.New("Vault returned no certificate")
+	}
+	certificate, err = parseCertificateResponse(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Issued certificate: "+certificate.SerialNumber)
+	} else {
+		logger.Info(ctx, "Issued certificate.")
+	}
+
+	return certificate, nil
+}
@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIssueCertificate tests IssueCertificate(), SignCertificate(), ListCertificates() and RevokeCertificate().
+func TestIssueCertificate(t *testing.T) {
+	// Enable the PKI secrets engine with a root CA and a role.
+	vaultClient.client.Sys().MountWithContext(ctx, defaultPKIMount, &vault.MountInput{Type: "pki"}) // nolint
+	_, err := vaultClient.client.Logical().WriteWithContext(ctx, defaultPKIMount+"/root/generate/internal", map[string]interface{}{
+		"common_name": "Test CA",
+		"ttl":         "24h",
+	})
+	assert.NoError(t, err)
+	_, err = vaultClient.client.Logical().WriteWithContext(ctx, defaultPKIMount+"/roles/test", map[string]interface{}{
+		"allow_subdomains": true,
+		"allowed_domains":  "example.com",
+		"max_ttl":          "1h",
+	})
+	assert.NoError(t, err)
+
+	// Issue certificate.
+	certificate, err := vaultClient.IssueCertificate(ctx, &CertificateRequest{
+		CommonName: "service.example.com",
+		Role:       "test",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "service.example.com", certificate.Certificate.Subject.CommonName)
+	assert.NotNil(t, certificate.PrivateKey)
+	assert.NotEmpty(t, certificate.Chain)
+
+	// Sign certificate.
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "csr.example.com"}}, key)
+	assert.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	assert.NoError(t, err)
+	signedCertificate, err := vaultClient.SignCertificate(ctx, csr, &CertificateRequest{Role: "test"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "csr.example.com", signedCertificate.Certificate.Subject.CommonName)
+		assert.Nil(t, signedCertificate.PrivateKey)
+	}
+
+	// List certificates.
+	serialNumbers, err := vaultClient.ListCertificates(ctx)
+	assert.NoError(t, err)
+	assert.Contains(t, serialNumbers, certificate.SerialNumber)
+
+	// Revoke certificate.
+	err = vaultClient.RevokeCertificate(ctx, certificate.SerialNumber)
+	assert.NoError(t, err)
+}
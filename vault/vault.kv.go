@@ -0,0 +1,269 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// Path of the KV secrets engine mount used when none is configured.
+	defaultMount = "secret"
+)
+
+// kvVersion returns the version of the KV secrets engine, detecting it on first use.
+func (v *Vault) kvVersion(ctx context.Context) (int, error) {
+	v.kvVersionLock.Lock()
+	defer v.kvVersionLock.Unlock()
+	if v.mountVersion != 0 {
+		return v.mountVersion, nil
+	}
+
+	// Read mount options.
+	var version string
+	mounts, err := v.client.Sys().ListMountsWithContext(ctx)
+	if err == nil {
+		if mount, ok := mounts[v.mount+"/"]; ok {
+			version = mount.Options["version"]
+		}
+	} else {
+		// Tokens without access to sys/mounts may still read the configuration of mounts they can use.
+		mountSecret, err := v.client.Logical().ReadWithContext(ctx, "sys/internal/ui/mounts/"+v.mount)
+		if err != nil {
+			return 0, wrapError("", err)
+		}
+		if mountSecret != nil {
+			if options, ok := mountSecret.Data["options"].(map[string]interface{}); ok {
+				version, _ = options["version"].(string) // nolint
+			}
+		}
+	}
+
+	v.mountVersion = 1
+	if version == "2" {
+		v.mountVersion = 2
+	}
+
+	return v.mountVersion, nil
+}
+
+// secretPath normalizes a secret path to start with the mount (e.g., "secret/app/database").
+// Paths may be relative to the mount or start with it; paths starting with "/" are absolute and must be within the mount and path prefix.
+// Relative paths starting with the default mount are rejected when another mount is configured, so that paths written for the default mount are not silently remapped; a folder of that name is addressed with the mount (e.g., "kv-payments/secret/app").
+// The path prefix is not included in the result.
+func (v *Vault) secretPath(path string) (string, error) {
+	switch {
+	case strings.HasPrefix(path, "/"):
+		mountPrefix := "/" + v.mount + "/" + v.pathPrefix
+		if !strings.HasPrefix(path, mountPrefix) {
+			return "", secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, path, errors.New("path is outside of mount: "+v.mount+"/"+v.pathPrefix))
+		}
+		path = strings.TrimPrefix(path, mountPrefix)
+	case strings.HasPrefix(path, v.mount+"/"):
+		path = strings.TrimPrefix(path, v.mount+"/")
+	case strings.HasPrefix(path, defaultMount+"/"):
+		return "", secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, path, errors.New("path is in mount "+defaultMount+", not the configured mount: "+v.mount))
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == ".." {
+			return "", secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, path, errors.New("path cannot traverse directories"))
+		}
+	}
+
+	return v.mount + "/" + path, nil
+}
+
+// kvPath returns the path of a normalized secret path relative to the mount, including the path prefix.
+func (v *Vault) kvPath(path string) string {
+	return v.pathPrefix + strings.TrimPrefix(path, v.mount+"/")
+}
+
+// toSecretMetadata converts KV version 2 metadata.
+func toSecretMetadata(kvMetadata *vault.KVMetadata) *secretprovidertype.SecretMetadata {
+	metadata := new(secretprovidertype.SecretMetadata)
+	if kvMetadata == nil {
+		return metadata
+	}
+	if !kvMetadata.CreatedTime.IsZero() {
+		created := kvMetadata.CreatedTime
+		metadata.Created = &created
+	}
+	if !kvMetadata.UpdatedTime.IsZero() {
+		updated := kvMetadata.UpdatedTime
+		metadata.Updated = &updated
+	}
+	if kvMetadata.CurrentVersion > 0 {
+		metadata.Version = strconv.Itoa(kvMetadata.CurrentVersion)
+	}
+	applyCustomMetadata(metadata, kvMetadata.CustomMetadata)
+
+	return metadata
+}
+
+// applyCustomMetadata copies KV version 2 custom metadata into descriptive metadata.
+func applyCustomMetadata(metadata *secretprovidertype.SecretMetadata, customMetadata map[string]interface{}) {
+	for key, value := range customMetadata {
+		stringValue, ok := value.(string)
+		if !ok {
+			continue
+		}
+		switch key {
+		case secretprovidertype.MetadataDescriptionKey:
+			metadata.Description = stringValue
+		case secretprovidertype.MetadataOwnerKey:
+			metadata.Owner = stringValue
+		default:
+			if metadata.Tags == nil {
+				metadata.Tags = make(map[string]string)
+			}
+			metadata.Tags[key] = stringValue
+		}
+	}
+}
+
+// isDeleted reports whether the current version of a KV version 2 secret is deleted or destroyed.
+func isDeleted(kvMetadata *vault.KVMetadata) bool {
+	versionMetadata, ok := kvMetadata.Versions[strconv.Itoa(kvMetadata.CurrentVersion)]
+	if !ok {
+		return true
+	}
+
+	return versionMetadata.Destroyed || (!versionMetadata.DeletionTime.IsZero() && !versionMetadata.DeletionTime.After(time.Now()))
+}
+
+// requireKVv2 returns an error unless the KV secrets engine is version 2.
+func (v *Vault) requireKVv2(ctx context.Context, path string) error {
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version != 2 {
+		return secretprovidertype.NewError(secretprovidertype.ErrNotSupported, path, errors.New("operation requires KV secrets engine version 2"))
+	}
+
+	return nil
+}
+
+// parseVersion parses a KV version 2 version identifier.
+func parseVersion(version string) (int, error) {
+	versionNumber, err := strconv.Atoi(version)
+	if err != nil || versionNumber < 1 {
+		return 0, errors.New("version must be a positive integer: " + version)
+	}
+
+	return versionNumber, nil
+}
+
+// toVersionMetadata converts the metadata of a KV version 2 secret version.
+func toVersionMetadata(kvSecret *vault.KVSecret) *secretprovidertype.SecretMetadata {
+	metadata := new(secretprovidertype.SecretMetadata)
+	if kvSecret.VersionMetadata != nil {
+		if !kvSecret.VersionMetadata.CreatedTime.IsZero() {
+			updated := kvSecret.VersionMetadata.CreatedTime
+			metadata.Updated = &updated
+		}
+		metadata.Version = strconv.Itoa(kvSecret.VersionMetadata.Version)
+	}
+	applyCustomMetadata(metadata, kvSecret.CustomMetadata)
+
+	return metadata
+}
+
+// readKV reads the current version of a secret from the KV secrets engine.
+func (v *Vault) readKV(ctx context.Context, path string) (*vault.KVSecret, error) {
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var kvSecret *vault.KVSecret
+	if version == 2 {
+		kvSecret, err = v.client.KVv2(v.mount).Get(ctx, v.kvPath(path))
+	} else {
+		kvSecret, err = v.client.KVv1(v.mount).Get(ctx, v.kvPath(path))
+	}
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	if kvSecret.Data == nil {
+		// Deleted and destroyed versions retain metadata but not data.
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, nil)
+	}
+
+	return kvSecret, nil
+}
+
+// writeKV writes a new version of a secret to the KV secrets engine.
+func (v *Vault) writeKV(ctx context.Context, path string, data map[string]interface{}) error {
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == 2 {
+		_, err = v.client.KVv2(v.mount).Put(ctx, v.kvPath(path), data)
+	} else {
+		err = v.client.KVv1(v.mount).Put(ctx, v.kvPath(path), data)
+	}
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	return nil
+}
+
+// deleteKV deletes a secret from the KV secrets engine, soft-deleting only the current version on KV version 2.
+func (v *Vault) deleteKV(ctx context.Context, path string) error {
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == 2 {
+		err = v.client.KVv2(v.mount).Delete(ctx, v.kvPath(path))
+	} else {
+		err = v.client.KVv1(v.mount).Delete(ctx, v.kvPath(path))
+	}
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	return nil
+}
+
+// purgeKV permanently deletes a secret from the KV secrets engine, including all versions and metadata on KV version 2.
+func (v *Vault) purgeKV(ctx context.Context, path string) error {
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == 2 {
+		err = v.client.KVv2(v.mount).DeleteMetadata(ctx, v.kvPath(path))
+	} else {
+		err = v.client.KVv1(v.mount).Delete(ctx, v.kvPath(path))
+	}
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	return nil
+}
+
+// parseVersions parses KV version 2 version identifiers.
+func parseVersions(versions []string) ([]int, error) {
+	if len(versions) == 0 {
+		return nil, errors.New("versions are required")
+	}
+	versionNumbers := make([]int, 0, len(versions))
+	for _, version := range versions {
+		versionNumber, err := parseVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		versionNumbers = append(versionNumbers, versionNumber)
+	}
+
+	return versionNumbers, nil
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"testing"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSecretPath tests secretPath() and kvPath().
+func TestSecretPath(t *testing.T) {
+	v := &Vault{
+		mount:      "kv-payments",
+		pathPrefix: "team/",
+	}
+
+	// Normalize relative and mount-qualified paths.
+	for _, path := range []string{"app/database", "kv-payments/app/database", "/kv-payments/team/app/database"} {
+		secretPath, err := v.secretPath(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "kv-payments/app/database", secretPath)
+		assert.Equal(t, "team/app/database", v.kvPath(secretPath))
+	}
+
+	// Reject paths outside of the mount, including relative paths in the default mount.
+	for _, path := range []string{"/secret/app/database", "/kv-payments/other/app/database", "app/../../database", "secret/app/database"} {
+		_, err := v.secretPath(path)
+		assert.ErrorIs(t, err, secretprovidertype.ErrInvalidPath)
+	}
+
+	// Address a folder named after the default mount through the configured mount.
+	secretPath, err := v.secretPath("kv-payments/secret/app")
+	assert.NoError(t, err)
+	assert.Equal(t, "kv-payments/secret/app", secretPath)
+
+	// Accept paths in the default mount when it is configured.
+	v.mount = defaultMount
+	for _, path := range []string{"app/database", "secret/app/database"} {
+		secretPath, err := v.secretPath(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret/app/database", secretPath)
+	}
+}
+
+// TestIsDeleted tests detecting deleted KV version 2 secrets.
+func TestIsDeleted(t *testing.T) {
+	kvMetadata := &vault.KVMetadata{
+		CurrentVersion: 2,
+		Versions: map[string]vault.KVVersionMetadata{
+			"1": {DeletionTime: time.Now().Add(-time.Hour), Version: 1},
+			"2": {Version: 2},
+		},
+	}
+	assert.False(t, isDeleted(kvMetadata))
+
+	// Current version deleted, scheduled for deletion or destroyed.
+	kvMetadata.Versions["2"] = vault.KVVersionMetadata{DeletionTime: time.Now().Add(-time.Minute), Version: 2}
+	assert.True(t, isDeleted(kvMetadata))
+	kvMetadata.Versions["2"] = vault.KVVersionMetadata{DeletionTime: time.Now().Add(time.Hour), Version: 2}
+	assert.False(t, isDeleted(kvMetadata))
+	kvMetadata.Versions["2"] = vault.KVVersionMetadata{Destroyed: true, Version: 2}
+	assert.True(t, isDeleted(kvMetadata))
+
+	// No versions.
+	assert.True(t, isDeleted(&vault.KVMetadata{}))
+}
@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// Time allowed to revoke watched leases when closing.
+	leaseRevokeTimeout = 10 * time.Second
+)
+
+// LeaseFunc is called when the lease of a watched secret can no longer be renewed, shortly before it expires, so that new credentials can be read.
+// The error is the reason renewal stopped, or nil if the lease reached its maximum time to live or is not renewable.
+type LeaseFunc func(secret *secretprovidertype.Secret, err error)
+
+// watchedLease is a lease renewed in the background.
+type watchedLease struct {
+	lease    *secretprovidertype.Lease // Current lease, replaced under leaseLock when renewed.
+	path     string
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// toLease returns the lease of a Vault secret, or nil if it has none.
+func toLease(secret *vault.Secret) *secretprovidertype.Lease {
+	if secret == nil || secret.LeaseID == "" {
+		return nil
+	}
+	lease := &secretprovidertype.Lease{
+		Duration:  time.Duration(secret.LeaseDuration) * time.Second,
+		ID:        secret.LeaseID,
+		Renewable: secret.Renewable,
+	}
+	if lease.Duration > 0 {
+		expiration := time.Now().Add(lease.Duration)
+		lease.Expiration = &expiration
+	}
+
+	return lease
+}
+
+// unwatchLease stops renewing a lease, returning whether it was watched.
+func (v *Vault) unwatchLease(leaseID string) bool {
+	v.leaseLock.Lock()
+	lease, ok := v.leases[leaseID]
+	delete(v.leases, leaseID)
+	v.leaseLock.Unlock()
+	if ok {
+		lease.stopOnce.Do(func() {
+			close(lease.stop)
+		})
+	}
+
+	return ok
+}
+
+// revokeLeases revokes every watched lease, returning the last error.
+func (v *Vault) revokeLeases(ctx context.Context) error {
+	v.leaseLock.Lock()
+	leaseIDs := make([]string, 0, len(v.leases))
+	for leaseID := range v.leases {
+		leaseIDs = append(leaseIDs, leaseID)
+	}
+	v.leases = make(map[string]*watchedLease)
+	v.leaseLock.Unlock()
+	if len(leaseIDs) == 0 {
+		return nil
+	}
+
+	// Revoke leases.
+	ctx, cancel := context.WithTimeout(ctx, leaseRevokeTimeout)
+	defer cancel()
+	var lastErr error
+	for _, leaseID := range leaseIDs {
+		err := v.client.Sys().RevokeWithContext(ctx, leaseID)
+		if err != nil {
+			lastErr = wrapError("", err)
+			logger.Warn(ctx, "Unable to revoke lease: "+lastErr.Error())
+		}
+	}
+
+	return lastErr
+}
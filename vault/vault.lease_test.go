@@ -0,0 +1,126 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLeases tests ReadLeasedSecret(), WatchLease(), RenewLease(), RevokeLease() and revoking leases on Close().
+func TestLeases(t *testing.T) {
+	// Run a node issuing dynamic credentials.
+	var (
+		lock    sync.Mutex
+		revoked []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&request) // nolint
+		switch r.URL.Path {
+		case "/v1/database/creds/shortlived":
+			json.NewEncoder(w).Encode(map[string]interface{}{ // nolint
+				"data":           map[string]interface{}{"username": "shortlived"},
+				"lease_duration": 1,
+				"lease_id":       "database/creds/shortlived/1",
+				"renewable":      false,
+			})
+		case "/v1/database/creds/longlived":
+			json.NewEncoder(w).Encode(map[string]interface{}{ // nolint
+				"data":           map[string]interface{}{"username": "longlived"},
+				"lease_duration": 3600,
+				"lease_id":       "database/creds/longlived/1",
+				"renewable":      true,
+			})
+		case "/v1/sys/leases/renew":
+			json.NewEncoder(w).Encode(map[string]interface{}{ // nolint
+				"lease_duration": 7200,
+				"lease_id":       request["lease_id"],
+				"renewable":      true,
+			})
+		case "/v1/sys/leases/revoke":
+			lock.Lock()
+			revoked = append(revoked, request["lease_id"].(string))
+			lock.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	vaultConfig := vault.DefaultConfig()
+	vaultConfig.Address = server.URL
+	client, err := vault.NewClient(vaultConfig)
+	if !assert.NoError(t, err) {
+		return
+	}
+	v := &Vault{
+		client: client,
+		done:   make(chan struct{}),
+		leases: make(map[string]*watchedLease),
+	}
+
+	// Read leased secrets.
+	shortLived, err := v.ReadLeasedSecret(ctx, "database/creds/shortlived")
+	if !assert.NoError(t, err) || !assert.NotNil(t, shortLived.Lease) {
+		return
+	}
+	assert.Equal(t, "shortlived", shortLived.Data["username"])
+	assert.Equal(t, "database/creds/shortlived/1", shortLived.Lease.ID)
+	assert.Equal(t, time.Second, shortLived.Lease.Duration)
+	assert.False(t, shortLived.Lease.Renewable)
+	longLived, err := v.ReadLeasedSecret(ctx, "database/creds/longlived")
+	if !assert.NoError(t, err) || !assert.NotNil(t, longLived.Lease) {
+		return
+	}
+	_, err = v.ReadLeasedSecret(ctx, "database/creds/missing")
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+	_, err = v.ReadLeasedSecret(ctx, "database/../sys/raw")
+	assert.ErrorIs(t, err, secretprovidertype.ErrInvalidPath)
+
+	// Notify when a lease cannot be renewed.
+	expired := make(chan *secretprovidertype.Secret, 1)
+	err = v.WatchLease(ctx, shortLived, func(secret *secretprovidertype.Secret, err error) {
+		expired <- secret
+	})
+	assert.NoError(t, err)
+	select {
+	case secret := <-expired:
+		assert.Equal(t, shortLived, secret)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "lease expiration was not notified")
+	}
+
+	// Renew leases.
+	lease, err := v.RenewLease(ctx, longLived.Lease.ID, time.Hour)
+	if assert.NoError(t, err) && assert.NotNil(t, lease) {
+		assert.Equal(t, 2*time.Hour, lease.Duration)
+	}
+
+	// Update watched leases when they are renewed.
+	err = v.WatchLease(ctx, longLived, nil)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		lease, err := v.WatchedLease(ctx, longLived.Lease.ID)
+
+		return err == nil && lease.Duration == 2*time.Hour && lease.Expiration != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, time.Hour, longLived.Lease.Duration)
+	_, err = v.WatchedLease(ctx, shortLived.Lease.ID)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+
+	// Revoke watched leases on close.
+	err = v.WatchLease(ctx, longLived, nil)
+	assert.ErrorIs(t, err, secretprovidertype.ErrAlreadyExists)
+	err = v.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{longLived.Lease.ID}, revoked)
+	err = v.WatchLease(ctx, longLived, nil)
+	assert.Error(t, err)
+}
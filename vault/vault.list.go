@@ -3,32 +3,158 @@ package vault
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 )
 
-// ListSecrets lists secret paths.
-func (v *Vault) ListSecrets(ctx context.Context, pathChannel chan string, errorChannel chan error) {
+// ListSecrets lists secret paths. Folder entries are only reported by WalkSecretPaths.
+func (v *Vault) ListSecrets(ctx context.Context, options *secretprovidertype.ListOptions, pathChannel chan string, errorChannel chan error) {
+	secretprovidertype.StreamSecretPaths(ctx, options, v.WalkSecretPaths, pathChannel, errorChannel)
+}
+
+// WalkSecretPaths calls fn for each secret path.
+func (v *Vault) WalkSecretPaths(ctx context.Context, options *secretprovidertype.ListOptions, fn secretprovidertype.PathWalkFunc) error {
 	// Validate parameters.
 	if ctx == nil {
-		errorChannel <- errors.New("context is required")
-
-		close(pathChannel)
-		close(errorChannel)
-
-		return
+		return errors.New("context is required")
+	}
+	if fn == nil {
+		return errors.New("walk function is required")
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
 
+	// Tags are stored as custom metadata, which requires KV version 2.
+	if options != nil && len(options.Tags) > 0 {
+		err := v.requireKVv2(ctx, "")
+		if err != nil {
+			return err
+		}
+	}
+
 	// List secrets.
-	// TODO.
+	folder, err := v.secretPath(options.Folder())
+	if err != nil {
+		return err
+	}
+	folder = strings.TrimPrefix(folder, v.mount+"/")
+	keys, err := v.listFolder(ctx, folder)
+	if err != nil {
+		return err
+	}
+	err = v.walkKeys(ctx, options, folder, keys, fn)
+	if err == secretprovidertype.StopWalk {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
 
 	// Log.
 	logger.Verbose(ctx, "Listed secrets.")
 
-	close(pathChannel)
-	close(errorChannel)
+	return nil
+}
+
+// listFolder returns the keys in a folder, where keys ending with "/" are subfolders.
+func (v *Vault) listFolder(ctx context.Context, folder string) ([]string, error) {
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listPath := v.mount + "/" + v.pathPrefix + folder
+	if version == 2 {
+		listPath = v.mount + "/metadata/" + v.pathPrefix + folder
+	}
+	vaultSecret, err := v.client.Logical().ListWithContext(ctx, listPath)
+	if err != nil {
+		return nil, wrapError(v.mount+"/"+folder, err)
+	}
+	if vaultSecret == nil {
+		return nil, nil
+	}
+	keyValues, _ := vaultSecret.Data["keys"].([]interface{}) // nolint
+	keys := make([]string, 0, len(keyValues))
+	for _, keyValue := range keyValues {
+		if key, ok := keyValue.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// walkKeys calls fn for each secret in a folder, descending into subfolders if recursive and otherwise reporting them as folders.
+// Subfolders that cannot be listed are reported to fn without stopping the walk.
+func (v *Vault) walkKeys(ctx context.Context, options *secretprovidertype.ListOptions, folder string, keys []string, fn secretprovidertype.PathWalkFunc) error {
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var err error
+		switch {
+		case !strings.HasSuffix(key, "/"):
+			var matches bool
+			matches, err = v.matchesOptions(ctx, options, folder+key)
+			if err != nil {
+				err = fn(v.mount+"/"+folder+key, false, err)
+			} else if matches {
+				err = fn(v.mount+"/"+folder+key, false, nil)
+			}
+		case options.IsRecursive():
+			var subkeys []string
+			subkeys, err = v.listFolder(ctx, folder+key)
+			if err != nil {
+				err = fn(v.mount+"/"+folder+key, true, err)
+			} else {
+				err = v.walkKeys(ctx, options, folder+key, subkeys, fn)
+			}
+		default:
+			err = fn(v.mount+"/"+folder+key, true, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchesOptions reports whether a secret matches the options.
+// On KV version 2, secrets whose current version is deleted are skipped unless deleted secrets are included, and tags are matched against custom metadata.
+func (v *Vault) matchesOptions(ctx context.Context, options *secretprovidertype.ListOptions, path string) (bool, error) {
+	checkDeleted := options == nil || !options.IncludeDeleted
+	checkTags := options != nil && len(options.Tags) > 0
+	if !checkTags {
+		if !checkDeleted {
+			return true, nil
+		}
+		version, err := v.kvVersion(ctx)
+		if err != nil {
+			return false, err
+		}
+		if version != 2 {
+			return true, nil
+		}
+	}
+	kvMetadata, err := v.client.KVv2(v.mount).GetMetadata(ctx, v.pathPrefix+path)
+	if err != nil {
+		err = wrapError(v.mount+"/"+path, err)
+		if errors.Is(err, secretprovidertype.ErrNotFound) {
+			// The secret was removed after its folder was listed.
+			return false, nil
+		}
+
+		return false, err
+	}
+	if checkDeleted && isDeleted(kvMetadata) {
+		return false, nil
+	}
+
+	return options.MatchesTags(toSecretMetadata(kvMetadata).Tags), nil
 }
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWalkSecretPathsOptions tests WalkSecretPaths() with prefix and recursion options.
+func TestWalkSecretPathsOptions(t *testing.T) {
+	for _, secretPath := range []string{"secret/listtree/top", "secret/listtree/app/one", "secret/listtree/app/database/two"} {
+		err := vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": "b"})
+		assert.NoError(t, err)
+	}
+
+	// List a folder recursively.
+	var paths []string
+	err := vaultClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{Prefix: "secret/listtree"}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		assert.False(t, folder)
+		paths = append(paths, path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"secret/listtree/top", "secret/listtree/app/one", "secret/listtree/app/database/two"}, paths)
+
+	// List a folder non-recursively.
+	var folders []string
+	paths = nil
+	err = vaultClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{NonRecursive: true, Prefix: "secret/listtree/app/"}, func(path string, folder bool, err error) error {
+		assert.NoError(t, err)
+		if folder {
+			folders = append(folders, path)
+		} else {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"secret/listtree/app/database/"}, folders)
+	assert.Equal(t, []string{"secret/listtree/app/one"}, paths)
+
+	// Skip deleted secrets unless they are included.
+	err = vaultClient.UpsertSecret(ctx, "secret/listtree/deleted/three", map[string]interface{}{"a": "b"})
+	assert.NoError(t, err)
+	err = vaultClient.DeleteSecret(ctx, "secret/listtree/deleted/three")
+	assert.NoError(t, err)
+	for _, includeDeleted := range []bool{false, true} {
+		paths = nil
+		err = vaultClient.WalkSecretPaths(ctx, &secretprovidertype.ListOptions{IncludeDeleted: includeDeleted, Prefix: "secret/listtree/deleted"}, func(path string, folder bool, err error) error {
+			assert.NoError(t, err)
+			paths = append(paths, path)
+
+			return nil
+		})
+		assert.NoError(t, err)
+		if includeDeleted {
+			assert.Equal(t, []string{"secret/listtree/deleted/three"}, paths)
+		} else {
+			assert.Empty(t, paths)
+		}
+	}
+	err = vaultClient.WalkSecrets(ctx, func(path string, secret *secretprovidertype.Secret, err error) error {
+		assert.NoError(t, err)
+		assert.NotEqual(t, "secret/listtree/deleted/three", path)
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// ListCertificates lists the serial numbers of certificates issued by the PKI secrets engine.
+func (v *Vault) ListCertificates(ctx context.Context) (serialNumbers []string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// List certificates.
+	secret, err := v.client.Logical().ListWithContext(ctx, v.pkiMount+"/certs")
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	serialNumbers = []string{}
+	if secret != nil {
+		if keys, ok := secret.Data["keys"].([]interface{}); ok {
+			for _, key := range keys {
+				if serialNumber, ok := key.(string); ok {
+					serialNumbers = append(serialNumbers, serialNumber)
+				}
+			}
+		}
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Listed certificates.")
+
+	return serialNumbers, nil
+}
@@ -0,0 +1,33 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// ListPolicies lists the names of ACL policies.
+func (v *Vault) ListPolicies(ctx context.Context) (names []string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// List policies.
+	names, err = v.client.Sys().ListPoliciesWithContext(ctx)
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	sort.Strings(names)
+
+	// Log.
+	logger.Verbose(ctx, "Listed policies.")
+
+	return names, nil
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ListSecretVersions lists the versions of a secret, oldest first.
+func (v *Vault) ListSecretVersions(ctx context.Context, path string) (versions []*secretprovidertype.SecretVersion, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	path, err = v.secretPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Read metadata.
+	err = v.requireKVv2(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	kvMetadata, err := v.client.KVv2(v.mount).GetMetadata(ctx, v.kvPath(path))
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+
+	// Convert versions.
+	for _, versionMetadata := range kvMetadata.Versions {
+		version := &secretprovidertype.SecretVersion{
+			Current:   versionMetadata.Version == kvMetadata.CurrentVersion,
+			Destroyed: versionMetadata.Destroyed,
+			Version:   strconv.Itoa(versionMetadata.Version),
+		}
+		if !versionMetadata.CreatedTime.IsZero() {
+			created := versionMetadata.CreatedTime
+			version.Created = &created
+		}
+		if !versionMetadata.DeletionTime.IsZero() {
+			deleted := versionMetadata.DeletionTime
+			version.Deleted = &deleted
+		}
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		iVersion, _ := strconv.Atoi(versions[i].Version) // nolint
+		jVersion, _ := strconv.Atoi(versions[j].Version) // nolint
+
+		return iVersion < jVersion
+	})
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Listed secret versions: "+path)
+	} else {
+		logger.Verbose(ctx, "Listed secret versions.")
+	}
+
+	return versions, nil
+}
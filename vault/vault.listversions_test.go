@@ -0,0 +1,40 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSecretVersions tests ListSecretVersions(), ReadSecretVersion() and RollbackSecret().
+func TestSecretVersions(t *testing.T) {
+	secretPath := "secret/versionsecret"
+	for _, value := range []string{"one", "two"} {
+		err := vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"value": value})
+		assert.NoError(t, err)
+	}
+
+	// List versions.
+	versions, err := vaultClient.ListSecretVersions(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.GreaterOrEqual(t, len(versions), 2) {
+		assert.True(t, versions[len(versions)-1].Current)
+	}
+
+	// Read a previous version.
+	previousVersion := versions[len(versions)-2].Version
+	secret, err := vaultClient.ReadSecretVersion(ctx, secretPath, previousVersion)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, "one", secret.Data["value"])
+	}
+
+	// Roll back to a previous version.
+	err = vaultClient.RollbackSecret(ctx, secretPath, previousVersion)
+	assert.NoError(t, err)
+	secret, err = vaultClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, "one", secret.Data["value"])
+	}
+}
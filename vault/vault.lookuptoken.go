@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// LookupToken looks up a token.
+func (v *Vault) LookupToken(ctx context.Context, token string) (*secretprovidertype.Token, error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if token == "" {
+		return nil, errors.New("token is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Look up token.
+	secret, err := v.client.Auth().Token().LookupWithContext(ctx, token)
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	result, err := toToken(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Looked up token.")
+
+	return result, nil
+}
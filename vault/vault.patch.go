@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// PatchSecret updates individual keys of an existing secret using JSON merge patch semantics.
+// Keys set to nil in the patch are removed.
+// KV version 2 mounts are patched by Vault; KV version 1 mounts fall back to reading and rewriting the secret.
+func (v *Vault) PatchSecret(ctx context.Context, path string, patch map[string]interface{}) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	path, err := v.secretPath(path)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Patch secret.
+	version, err := v.kvVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == 2 {
+		_, err = v.client.KVv2(v.mount).Patch(ctx, v.kvPath(path), patch)
+		if err != nil {
+			return wrapError(path, err)
+		}
+	} else {
+		kvSecret, err := v.readKV(ctx, path)
+		if err != nil {
+			return err
+		}
+		err = v.writeKV(ctx, path, secretprovidertype.MergePatch(kvSecret.Data, patch))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Patched secret: "+path)
+	} else {
+		logger.Info(ctx, "Patched secret.")
+	}
+
+	return nil
+}
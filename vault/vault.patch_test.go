@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPatchSecret tests PatchSecret().
+func TestPatchSecret(t *testing.T) {
+	secretPath := "secret/patchsecret"
+	err := vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{
+		"keep":   "value",
+		"nested": map[string]interface{}{"keep": "value", "remove": "value"},
+		"remove": "value",
+		"update": "old",
+	})
+	assert.NoError(t, err)
+
+	// Patch the secret, merging nested objects.
+	err = vaultClient.PatchSecret(ctx, secretPath, map[string]interface{}{
+		"add":    "value",
+		"nested": map[string]interface{}{"add": "value", "remove": nil},
+		"remove": nil,
+		"update": "new",
+	})
+	assert.NoError(t, err)
+	secret, err := vaultClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, map[string]interface{}{
+			"add":    "value",
+			"keep":   "value",
+			"nested": map[string]interface{}{"add": "value", "keep": "value"},
+			"update": "new",
+		}, secret.Data)
+	}
+
+	// Patch a secret that does not exist.
+	err = vaultClient.PatchSecret(ctx, "secret/patchsecretmissing", map[string]interface{}{"value": "one"})
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
@@ -0,0 +1,195 @@
+package vault
+
+import (
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default mount of the PKI secrets engine.
+const defaultPKIMount = "pki"
+
+// CertificateRequest configures the issuance of a certificate by the PKI secrets engine.
+type CertificateRequest struct {
+	AltNames   []string      // Subject alternative DNS names and email addresses.
+	CommonName string        // Common name of the certificate, if not taken from a signed CSR.
+	IPSANs     []string      // Subject alternative IP addresses.
+	Role       string        // Role to issue the certificate against, if not the secret provider's PKI role.
+	TTL        time.Duration // Time to live of the certificate, if not the role's default.
+	URISANs    []string      // Subject alternative URIs.
+}
+
+// Certificate is a certificate issued by the PKI secrets engine.
+type Certificate struct {
+	Certificate    *x509.Certificate   // Issued certificate.
+	CertificatePEM []byte              // PEM-encoded issued certificate.
+	Chain          []*x509.Certificate // Chain of issuing CA certificates, starting with the issuer.
+	ChainPEM       []byte              // PEM-encoded chain of issuing CA certificates.
+	PrivateKey     crypto.Signer       // Private key of the certificate, or nil if a CSR was signed.
+	PrivateKeyPEM  []byte              // PEM-encoded private key, or nil if a CSR was signed.
+	SerialNumber   string              // Serial number, formatted as colon-separated hex bytes.
+}
+
+// TLSCertificate returns the certificate, its chain and its private key as a TLS certificate.
+func (c *Certificate) TLSCertificate() (tls.Certificate, error) {
+	if c.PrivateKey == nil {
+		return tls.Certificate{}, errors.New("certificate has no private key")
+	}
+	tlsCertificate := tls.Certificate{
+		Certificate: [][]byte{c.Certificate.Raw},
+		Leaf:        c.Certificate,
+		PrivateKey:  c.PrivateKey,
+	}
+	for _, chainCertificate := range c.Chain {
+		tlsCertificate.Certificate = append(tlsCertificate.Certificate, chainCertificate.Raw)
+	}
+
+	return tlsCertificate, nil
+}
+
+// certificateRole returns the role to issue a certificate against.
+func (v *Vault) certificateRole(request *CertificateRequest) (string, error) {
+	role := request.Role
+	if role == "" {
+		role = v.pkiRole
+	}
+	if role == "" {
+		return "", errors.New("PKI role is required")
+	}
+	if strings.Contains(role, "/") {
+		return "", errors.New("PKI role is invalid: " + role)
+	}
+
+	return role, nil
+}
+
+// certificateRequestData converts a certificate request to the parameters of the PKI secrets engine.
+func certificateRequestData(request *CertificateRequest) map[string]interface{} {
+	data := map[string]interface{}{
+		"format": "pem",
+	}
+	if request.CommonName != "" {
+		data["common_name"] = request.CommonName
+	}
+	if len(request.AltNames) > 0 {
+		data["alt_names"] = strings.Join(request.AltNames, ",")
+	}
+	if len(request.IPSANs) > 0 {
+		data["ip_sans"] = strings.Join(request.IPSANs, ",")
+	}
+	if len(request.URISANs) > 0 {
+		data["uri_sans"] = strings.Join(request.URISANs, ",")
+	}
+	if request.TTL > 0 {
+		data["ttl"] = strconv.FormatInt(int64(request.TTL/time.Second), 10) + "s"
+	}
+
+	return data
+}
+
+// parseCertificateResponse parses the certificate, chain and private key returned by the PKI secrets engine.
+func parseCertificateResponse(data map[string]interface{}) (*Certificate, error) {
+	certificatePEM, _ := data["certificate"].(string) // nolint
+	if certificatePEM == "" {
+		return nil, errors.New("Vault returned no certificate")
+	}
+	certificates, err := parseCertificates([]byte(certificatePEM))
+	if err != nil {
+		return nil, err
+	}
+	certificate := &Certificate{
+		Certificate:    certificates[0],
+		CertificatePEM: []byte(certificatePEM),
+	}
+	certificate.SerialNumber, _ = data["serial_number"].(string) // nolint
+
+	// Parse chain, which is returned with the issuing CA last if it is available.
+	var chainPEM []string
+	if caChain, ok := data["ca_chain"].([]interface{}); ok {
+		for _, chainEntry := range caChain {
+			if chainEntryPEM, ok := chainEntry.(string); ok {
+				chainPEM = append(chainPEM, strings.TrimSpace(chainEntryPEM))
+			}
+		}
+	}
+	if issuingCA, ok := data["issuing_ca"].(string); len(chainPEM) == 0 && ok && issuingCA != "" {
+		chainPEM = append(chainPEM, strings.TrimSpace(issuingCA))
+	}
+	if len(chainPEM) > 0 {
+		certificate.ChainPEM = []byte(strings.Join(chainPEM, "\n") + "\n")
+		certificate.Chain, err = parseCertificates(certificate.ChainPEM)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse private key.
+	if privateKeyPEM, ok := data["private_key"].(string); ok && privateKeyPEM != "" {
+		certificate.PrivateKeyPEM = []byte(privateKeyPEM)
+		certificate.PrivateKey, err = parsePrivateKey(certificate.PrivateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return certificate, nil
+}
+
+// parseCertificates parses PEM-encoded certificates.
+func parseCertificates(certificatesPEM []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, certificatesPEM = pem.Decode(certificatesPEM)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return nil, errors.New("no certificates found in PEM data")
+	}
+
+	return certificates, nil
+}
+
+// parsePrivateKey parses a PEM-encoded RSA, EC or PKCS #8 private key.
+func parsePrivateKey(privateKeyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("no private key found in PEM data")
+	}
+	var (
+		err        error
+		privateKey interface{}
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key cannot sign")
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseCertificateResponse tests parsing certificates returned by the PKI secrets engine.
+func TestParseCertificateResponse(t *testing.T) {
+	// Create a CA and a certificate issued by it.
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		NotAfter:              time.Now().Add(time.Hour),
+		NotBefore:             time.Now(),
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		NotAfter:     time.Now().Add(time.Hour),
+		NotBefore:    time.Now(),
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test.example.com"},
+	}
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+
+	// Parse the response.
+	certificate, err := parseCertificateResponse(map[string]interface{}{
+		"ca_chain":      []interface{}{caPEM},
+		"certificate":   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER})),
+		"issuing_ca":    caPEM,
+		"private_key":   string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		"serial_number": "02",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "test.example.com", certificate.Certificate.Subject.CommonName)
+	assert.Equal(t, "02", certificate.SerialNumber)
+	if assert.Len(t, certificate.Chain, 1) {
+		assert.Equal(t, "Test CA", certificate.Chain[0].Subject.CommonName)
+	}
+	assert.NotNil(t, certificate.PrivateKey)
+	tlsCertificate, err := certificate.TLSCertificate()
+	assert.NoError(t, err)
+	assert.Len(t, tlsCertificate.Certificate, 2)
+
+	// Reject responses without certificates.
+	_, err = parseCertificateResponse(map[string]interface{}{})
+	assert.Error(t, err)
+}
+
+// TestCertificateRequestData tests converting certificate requests.
+func TestCertificateRequestData(t *testing.T) {
+	data := certificateRequestData(&CertificateRequest{
+		AltNames:   []string{"a.example.com", "b.example.com"},
+		CommonName: "example.com",
+		IPSANs:     []string{"127.0.0.1"},
+		TTL:        time.Hour,
+	})
+	assert.Equal(t, "example.com", data["common_name"])
+	assert.Equal(t, "a.example.com,b.example.com", data["alt_names"])
+	assert.Equal(t, "127.0.0.1", data["ip_sans"])
+	assert.Equal(t, "3600s", data["ttl"])
+	assert.NotContains(t, data, "uri_sans")
+
+	// Require a role.
+	_, err := (&Vault{}).certificateRole(&CertificateRequest{})
+	assert.Error(t, err)
+	role, err := (&Vault{pkiRole: "default"}).certificateRole(&CertificateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", role)
+}
@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+)
+
+// policyDocument is the HCL or JSON representation of a Vault policy.
+type policyDocument struct {
+	Path map[string]*policyDocumentRule `hcl:"path"`
+}
+
+// policyDocumentRule is the HCL or JSON representation of a Vault policy rule.
+type policyDocumentRule struct {
+	AllowedParameters  map[string][]interface{} `hcl:"allowed_parameters"`
+	Capabilities       []string                 `hcl:"capabilities"`
+	DeniedParameters   map[string][]interface{} `hcl:"denied_parameters"`
+	MaxWrappingTTL     interface{}              `hcl:"max_wrapping_ttl"`
+	MinWrappingTTL     interface{}              `hcl:"min_wrapping_ttl"`
+	Policy             string                   `hcl:"policy"`
+	RequiredParameters []string                 `hcl:"required_parameters"`
+}
+
+// policyDocumentRuleKeys are the keys of a policy rule that can be represented, so that rules are not widened by dropping others.
+var policyDocumentRuleKeys = map[string]bool{
+	"allowed_parameters":  true,
+	"capabilities":        true,
+	"denied_parameters":   true,
+	"max_wrapping_ttl":    true,
+	"min_wrapping_ttl":    true,
+	"policy":              true,
+	"required_parameters": true,
+}
+
+// legacyPolicyCapabilities maps the deprecated policy field of a rule to capabilities.
+var legacyPolicyCapabilities = map[string][]string{
+	"deny":  {secretprovidertype.CapabilityDeny},
+	"read":  {secretprovidertype.CapabilityRead, secretprovidertype.CapabilityList},
+	"write": {secretprovidertype.CapabilityCreate, secretprovidertype.CapabilityRead, secretprovidertype.CapabilityUpdate, secretprovidertype.CapabilityDelete, secretprovidertype.CapabilityList},
+	"sudo":  {secretprovidertype.CapabilityCreate, secretprovidertype.CapabilityRead, secretprovidertype.CapabilityUpdate, secretprovidertype.CapabilityDelete, secretprovidertype.CapabilityList, secretprovidertype.CapabilitySudo},
+}
+
+// renderPolicy renders the rules of a policy as HCL.
+func renderPolicy(policy *secretprovidertype.Policy) string {
+	var builder strings.Builder
+	for i, rule := range policy.Rules {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString("path " + strconv.Quote(rule.Path) + " {\n")
+		builder.WriteString("  capabilities = " + renderPolicyList(rule.Capabilities) + "\n")
+		renderPolicyParameters(&builder, "allowed_parameters", rule.AllowedParameters)
+		renderPolicyParameters(&builder, "denied_parameters", rule.DeniedParameters)
+		if len(rule.RequiredParameters) > 0 {
+			builder.WriteString("  required_parameters = " + renderPolicyList(rule.RequiredParameters) + "\n")
+		}
+		if rule.MinWrappingTTL > 0 {
+			builder.WriteString("  min_wrapping_ttl = " + strconv.Quote(rule.MinWrappingTTL.String()) + "\n")
+		}
+		if rule.MaxWrappingTTL > 0 {
+			builder.WriteString("  max_wrapping_ttl = " + strconv.Quote(rule.MaxWrappingTTL.String()) + "\n")
+		}
+		builder.WriteString("}\n")
+	}
+
+	return builder.String()
+}
+
+// renderPolicyList renders a list of strings as HCL.
+func renderPolicyList(values []string) string {
+	quotedValues := make([]string, 0, len(values))
+	for _, value := range values {
+		quotedValues = append(quotedValues, strconv.Quote(value))
+	}
+
+	return "[" + strings.Join(quotedValues, ", ") + "]"
+}
+
+// renderPolicyParameters renders the allowed or denied parameters of a rule as HCL, sorted by name.
+func renderPolicyParameters(builder *strings.Builder, key string, parameters map[string][]interface{}) {
+	if len(parameters) == 0 {
+		return
+	}
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	builder.WriteString("  " + key + " = {\n")
+	for _, name := range names {
+		renderedValues := make([]string, 0, len(parameters[name]))
+		for _, value := range parameters[name] {
+			if stringValue, ok := value.(string); ok {
+				renderedValues = append(renderedValues, strconv.Quote(stringValue))
+			} else {
+				renderedValues = append(renderedValues, fmt.Sprint(value))
+			}
+		}
+		builder.WriteString("    " + strconv.Quote(name) + " = [" + strings.Join(renderedValues, ", ") + "]\n")
+	}
+	builder.WriteString("  }\n")
+}
+
+// parsePolicy parses the HCL or JSON rules of a policy, sorting rules by path.
+// Rules with keys that cannot be represented (e.g., control groups) are rejected rather than dropped.
+func parsePolicy(name string, rules string) (*secretprovidertype.Policy, error) {
+	file, err := hcl.Parse(rules)
+	if err != nil {
+		return nil, err
+	}
+	err = validatePolicyRuleKeys(file)
+	if err != nil {
+		return nil, err
+	}
+	var document policyDocument
+	err = hcl.DecodeObject(&document, file)
+	if err != nil {
+		return nil, err
+	}
+	policy := &secretprovidertype.Policy{
+		Name: name,
+	}
+	for path, documentRule := range document.Path {
+		rule := &secretprovidertype.PolicyRule{
+			AllowedParameters:  documentRule.AllowedParameters,
+			Capabilities:       documentRule.Capabilities,
+			DeniedParameters:   documentRule.DeniedParameters,
+			Path:               path,
+			RequiredParameters: documentRule.RequiredParameters,
+		}
+		if len(rule.Capabilities) == 0 {
+			rule.Capabilities = legacyPolicyCapabilities[documentRule.Policy]
+		}
+		rule.MinWrappingTTL, err = parseWrappingTTL(documentRule.MinWrappingTTL)
+		if err != nil {
+			return nil, errors.New("invalid min_wrapping_ttl of rule " + path + ": " + err.Error())
+		}
+		rule.MaxWrappingTTL, err = parseWrappingTTL(documentRule.MaxWrappingTTL)
+		if err != nil {
+			return nil, errors.New("invalid max_wrapping_ttl of rule " + path + ": " + err.Error())
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	sort.Slice(policy.Rules, func(i, j int) bool {
+		return policy.Rules[i].Path < policy.Rules[j].Path
+	})
+
+	return policy, nil
+}
+
+// validatePolicyRuleKeys returns an error if a rule of a parsed policy has a key that cannot be represented.
+func validatePolicyRuleKeys(file *ast.File) error {
+	list, ok := file.Node.(*ast.ObjectList)
+	if !ok {
+		return errors.New("policy must be an object")
+	}
+	for _, item := range list.Filter("path").Items {
+		ruleObject, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			continue
+		}
+		for _, ruleItem := range ruleObject.List.Items {
+			if len(ruleItem.Keys) == 0 {
+				continue
+			}
+			key, _ := ruleItem.Keys[0].Token.Value().(string) // nolint
+			if !policyDocumentRuleKeys[key] {
+				return errors.New("unsupported policy rule key: " + key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseWrappingTTL parses a wrapping TTL, given as a duration string or a number of seconds.
+func parseWrappingTTL(value interface{}) (time.Duration, error) {
+	switch ttl := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return time.Duration(ttl) * time.Second, nil
+	case int64:
+		return time.Duration(ttl) * time.Second, nil
+	case float64:
+		return time.Duration(ttl * float64(time.Second)), nil
+	case string:
+		seconds, err := strconv.ParseInt(ttl, 10, 64)
+		if err == nil {
+			return time.Duration(seconds) * time.Second, nil
+		}
+
+		return time.ParseDuration(ttl)
+	default:
+		return 0, fmt.Errorf("unsupported type: %T", value)
+	}
+}
@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"testing"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPolicies tests WritePolicy(), ReadPolicy(), ListPolicies() and DeletePolicy().
+func TestPolicies(t *testing.T) {
+	policy := &secretprovidertype.Policy{
+		Name: "testpolicy",
+		Rules: []*secretprovidertype.PolicyRule{
+			{Capabilities: []string{secretprovidertype.CapabilityDeny}, Path: "secret/data/private/*"},
+			{Capabilities: []string{secretprovidertype.CapabilityRead, secretprovidertype.CapabilityList}, Path: "secret/data/*"},
+		},
+	}
+
+	// Write policy.
+	err := vaultClient.WritePolicy(ctx, policy)
+	assert.NoError(t, err)
+
+	// Read policy.
+	readPolicy, err := vaultClient.ReadPolicy(ctx, "testpolicy")
+	if assert.NoError(t, err) && assert.NotNil(t, readPolicy) {
+		assert.ElementsMatch(t, policy.Rules, readPolicy.Rules)
+	}
+
+	// List policies.
+	names, err := vaultClient.ListPolicies(ctx)
+	assert.NoError(t, err)
+	assert.Contains(t, names, "testpolicy")
+
+	// Delete policy.
+	err = vaultClient.DeletePolicy(ctx, "testpolicy")
+	assert.NoError(t, err)
+	_, err = vaultClient.ReadPolicy(ctx, "testpolicy")
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
+
+// TestParsePolicy tests rendering and parsing policies.
+func TestParsePolicy(t *testing.T) {
+	policy := &secretprovidertype.Policy{
+		Name: "test",
+		Rules: []*secretprovidertype.PolicyRule{
+			{Capabilities: []string{"read", "list"}, Path: "secret/data/*"},
+			{Capabilities: []string{"deny"}, Path: "secret/data/\"quoted\""},
+			{
+				AllowedParameters:  map[string][]interface{}{"*": {}, "ttl": {"1h", 3600}},
+				Capabilities:       []string{"create", "update"},
+				DeniedParameters:   map[string][]interface{}{"admin": {true}},
+				MaxWrappingTTL:     time.Hour,
+				MinWrappingTTL:     time.Minute,
+				Path:               "auth/token/create",
+				RequiredParameters: []string{"ttl"},
+			},
+		},
+	}
+	rendered := renderPolicy(policy)
+	assert.Contains(t, rendered, `path "secret/data/*" {`)
+	parsedPolicy, err := parsePolicy("test", rendered)
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, policy.Rules, parsedPolicy.Rules)
+	}
+
+	// Parse JSON and legacy policies.
+	parsedPolicy, err = parsePolicy("test", `{"path":{"secret/*":{"policy":"read"}}}`)
+	if assert.NoError(t, err) && assert.Len(t, parsedPolicy.Rules, 1) {
+		assert.Equal(t, []string{"read", "list"}, parsedPolicy.Rules[0].Capabilities)
+	}
+	parsedPolicy, err = parsePolicy("test", `{"path":{"secret/*":{"capabilities":["read"],"min_wrapping_ttl":60,"max_wrapping_ttl":"1h"}}}`)
+	if assert.NoError(t, err) && assert.Len(t, parsedPolicy.Rules, 1) {
+		assert.Equal(t, time.Minute, parsedPolicy.Rules[0].MinWrappingTTL)
+		assert.Equal(t, time.Hour, parsedPolicy.Rules[0].MaxWrappingTTL)
+	}
+	_, err = parsePolicy("test", `path "secret/*" {`)
+	assert.Error(t, err)
+
+	// Reject rules that cannot be represented, rather than widening them.
+	_, err = parsePolicy("test", `path "secret/*" { capabilities = ["read"] control_group = { max_ttl = "1h" } }`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+)
+
+// PurgeSecret permanently deletes a secret, including every version and its metadata on KV secrets engine version 2.
+func (v *Vault) PurgeSecret(ctx context.Context, path string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	path, err := v.secretPath(path)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Purge secret.
+	err = v.purgeKV(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Purged secret: "+path)
+	} else {
+		logger.Info(ctx, "Purged secret.")
+	}
+
+	return nil
+}
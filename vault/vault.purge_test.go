@@ -0,0 +1,36 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPurgeSecret tests DeleteSecret() and PurgeSecret().
+func TestPurgeSecret(t *testing.T) {
+	secretPath := "secret/purgesecret"
+	err := vaultClient.PurgeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	err = vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"value": "one"})
+	assert.NoError(t, err)
+
+	// Soft-delete the secret, then restore it.
+	err = vaultClient.DeleteSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	_, err = vaultClient.ReadSecret(ctx, secretPath)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+	err = vaultClient.UndeleteSecretVersions(ctx, secretPath, []string{"1"})
+	assert.NoError(t, err)
+	secret, err := vaultClient.ReadSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, map[string]interface{}{"value": "one"}, secret.Data)
+	}
+
+	// Purge the secret.
+	err = vaultClient.PurgeSecret(ctx, secretPath)
+	assert.NoError(t, err)
+	_, err = vaultClient.DescribeSecret(ctx, secretPath)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
+}
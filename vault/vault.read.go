@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"os"
-	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
@@ -21,29 +20,33 @@ func (v *Vault) ReadSecret(ctx context.Context, path string) (secret *secretprov
 	if path == "" {
 		return nil, errors.New("path is required")
 	}
-	if !strings.HasPrefix(path, "secret/") {
-		path = "secret/" + path
+	path, err = v.secretPath(path)
+	if err != nil {
+		return nil, err
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
 
 	// Read secret.
-	vaultSecret, err := v.client.Logical().Read(path)
-	if err != nil {
-		return nil, err
-	}
-	if vaultSecret == nil {
+	kvSecret, err := v.readKV(ctx, path)
+	if errors.Is(err, secretprovidertype.ErrNotFound) {
 		if os.Getenv(env.Debug) != "" {
 			logger.Verbose(ctx, "Secret does not exist: "+path)
 		} else {
 			logger.Verbose(ctx, "Secret does not exist.")
 		}
 
-		return nil, errors.New("not found")
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
 	}
 	secret = new(secretprovidertype.Secret)
-	secret.Data = vaultSecret.Data
+	secret.Data = kvSecret.Data
+	if kvSecret.VersionMetadata != nil {
+		secret.Metadata = toVersionMetadata(kvSecret)
+	}
 	secret.Path = path
 
 	// Log.
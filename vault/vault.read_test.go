@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"encoding/json"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,5 +32,5 @@ func TestReadSecret(t *testing.T) {
 	// Read nonexistent secret.
 	readSecret, err = vaultClient.ReadSecret(ctx, "secret/nonexistent/path")
 	assert.Error(t, err)
-	assert.Equal(t, "not found", err)
+	assert.ErrorIs(t, err, secretprovidertype.ErrNotFound)
 }
@@ -3,33 +3,107 @@ package vault
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
 	secretprovidertype "github.com/bertjohnson/secretprovider/types"
 )
 
+const (
+	// Maximum number of secrets read concurrently by WalkSecrets.
+	readConcurrency = 8
+)
+
 // ReadAllSecrets reads all secrets.
 func (v *Vault) ReadAllSecrets(ctx context.Context, secretChannel chan *secretprovidertype.Secret, errorChannel chan error) {
+	secretprovidertype.StreamSecrets(ctx, v.WalkSecrets, secretChannel, errorChannel)
+}
+
+// WalkSecrets calls fn for each secret.
+// Secrets are read concurrently, so fn may be called in any order, but never concurrently.
+// Deleted secrets, including secrets deleted while walking, are skipped.
+func (v *Vault) WalkSecrets(ctx context.Context, fn secretprovidertype.SecretWalkFunc) error {
 	// Validate parameters.
 	if ctx == nil {
-		errorChannel <- errors.New("context is required")
-
-		close(secretChannel)
-		close(errorChannel)
-
-		return
+		return errors.New("context is required")
+	}
+	if fn == nil {
+		return errors.New("walk function is required")
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Serialize calls to fn, stopping the walk once it returns an error.
+	var (
+		fnErr  error
+		fnLock sync.Mutex
+	)
+	call := func(path string, secret *secretprovidertype.Secret, err error) {
+		fnLock.Lock()
+		defer fnLock.Unlock()
+		if fnErr != nil {
+			return
+		}
+		fnErr = fn(path, secret, err)
+		if fnErr != nil {
+			cancel()
+		}
+	}
 
-	// Read all secrets.
-	// TODO.
+	// Read secrets.
+	pathChannel := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < readConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range pathChannel {
+				secret, err := v.ReadSecret(walkCtx, path)
+				if walkCtx.Err() != nil || errors.Is(err, secretprovidertype.ErrNotFound) {
+					// Skip secrets deleted after they were listed.
+					continue
+				}
+				call(path, secret, err)
+			}
+		}()
+	}
+
+	// List secrets.
+	err := v.WalkSecretPaths(walkCtx, nil, func(path string, folder bool, err error) error {
+		if err != nil {
+			call(path, nil, err)
+
+			return walkCtx.Err()
+		}
+
+		select {
+		case pathChannel <- path:
+			return nil
+		case <-walkCtx.Done():
+			return walkCtx.Err()
+		}
+	})
+	close(pathChannel)
+	wg.Wait()
+	if fnErr == secretprovidertype.StopWalk {
+		return nil
+	}
+	if fnErr != nil {
+		return fnErr
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
 
 	// Log.
 	logger.Verbose(ctx, "Read all secrets.")
 
-	close(secretChannel)
-	close(errorChannel)
+	return nil
 }
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWalkSecrets tests WalkSecrets() and ReadAllSecrets().
+func TestWalkSecrets(t *testing.T) {
+	for _, secretPath := range []string{"secret/readall/one", "secret/readall/nested/two"} {
+		err := vaultClient.UpsertSecret(ctx, secretPath, map[string]interface{}{"a": secretPath})
+		assert.NoError(t, err)
+	}
+
+	// Walk secrets.
+	secrets := make(map[string]*secretprovidertype.Secret)
+	err := vaultClient.WalkSecrets(ctx, func(path string, secret *secretprovidertype.Secret, err error) error {
+		assert.NoError(t, err)
+		secrets[path] = secret
+
+		return nil
+	})
+	assert.NoError(t, err)
+	for _, secretPath := range []string{"secret/readall/one", "secret/readall/nested/two"} {
+		if assert.Contains(t, secrets, secretPath) {
+			assert.Equal(t, secretPath, secrets[secretPath].Data["a"])
+		}
+	}
+
+	// Stop walking early.
+	count := 0
+	err = vaultClient.WalkSecrets(ctx, func(path string, secret *secretprovidertype.Secret, err error) error {
+		count++
+
+		return secretprovidertype.StopWalk
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Read all secrets through channels.
+	secretChannel := make(chan *secretprovidertype.Secret)
+	errorChannel := make(chan error)
+	go vaultClient.ReadAllSecrets(ctx, secretChannel, errorChannel)
+	count = 0
+	for range secretChannel {
+		count++
+	}
+	for err = range errorChannel {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, len(secrets), count)
+}
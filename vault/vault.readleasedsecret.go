@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ReadLeasedSecret reads a dynamic secret from any secrets engine (e.g., database/creds/readonly), including its lease.
+// The path is a Vault API path, not a path within the KV mount.
+func (v *Vault) ReadLeasedSecret(ctx context.Context, path string) (secret *secretprovidertype.Secret, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == ".." {
+			return nil, secretprovidertype.NewError(secretprovidertype.ErrInvalidPath, path, errors.New("path cannot traverse directories"))
+		}
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Read secret.
+	vaultSecret, err := v.client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	if vaultSecret == nil {
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, errors.New("secret not found"))
+	}
+	secret = &secretprovidertype.Secret{
+		Data:  vaultSecret.Data,
+		Lease: toLease(vaultSecret),
+		Path:  path,
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Read leased secret: "+path)
+	} else {
+		logger.Verbose(ctx, "Read leased secret.")
+	}
+
+	return secret, nil
+}
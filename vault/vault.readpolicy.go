@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ReadPolicy reads an ACL policy.
+func (v *Vault) ReadPolicy(ctx context.Context, name string) (policy *secretprovidertype.Policy, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	err = secretprovidertype.ValidatePolicyName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Read policy.
+	rules, err := v.client.Sys().GetPolicyWithContext(ctx, name)
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	if rules == "" {
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, "", errors.New("policy not found: "+name))
+	}
+	policy, err = parsePolicy(name, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Read policy: "+name)
+	} else {
+		logger.Verbose(ctx, "Read policy.")
+	}
+
+	return policy, nil
+}
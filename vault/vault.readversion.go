@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// ReadSecretVersion returns a specific version of a secret.
+func (v *Vault) ReadSecretVersion(ctx context.Context, path string, version string) (secret *secretprovidertype.Secret, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	versionNumber, err := parseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	path, err = v.secretPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Read secret version.
+	err = v.requireKVv2(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	kvSecret, err := v.client.KVv2(v.mount).GetVersion(ctx, v.kvPath(path), versionNumber)
+	if err != nil {
+		return nil, wrapError(path, err)
+	}
+	if kvSecret.Data == nil {
+		// Deleted and destroyed versions retain metadata but not data.
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, path, nil)
+	}
+	secret = &secretprovidertype.Secret{
+		Data:     kvSecret.Data,
+		Metadata: toVersionMetadata(kvSecret),
+		Path:     path,
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Read secret version: "+path+"@"+strconv.Itoa(versionNumber))
+	} else {
+		logger.Verbose(ctx, "Read secret version.")
+	}
+
+	return secret, nil
+}
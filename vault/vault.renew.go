@@ -0,0 +1,161 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// Time to wait before retrying a failed login while renewing.
+	loginRetryInterval = 10 * time.Second
+)
+
+// TokenExpiration returns when the current Vault token expires, or the zero time if it does not expire.
+func (v *Vault) TokenExpiration() time.Time {
+	v.authLock.Lock()
+	defer v.authLock.Unlock()
+
+	return v.tokenExpiration
+}
+
+// RenewalError returns the error from the most recent failed attempt to renew or replace the Vault token, or nil if it succeeded.
+func (v *Vault) RenewalError() error {
+	v.authLock.Lock()
+	defer v.authLock.Unlock()
+
+	return v.renewalErr
+}
+
+// startRenewal starts renewing the Vault token in the background until Close is called.
+func (v *Vault) startRenewal(ctx context.Context) {
+	v.authLock.Lock()
+	authSecret := v.authSecret
+	v.authLock.Unlock()
+
+	// Static tokens are looked up to determine whether they expire.
+	if authSecret == nil {
+		tokenSecret, err := v.client.Auth().Token().LookupSelfWithContext(ctx)
+		if err != nil {
+			logger.Warn(ctx, "Unable to look up Vault token; it will not be renewed: "+wrapError("", err).Error())
+
+			return
+		}
+		ttl, _ := tokenSecret.TokenTTL()               // nolint
+		renewable, _ := tokenSecret.TokenIsRenewable() // nolint
+		if ttl <= 0 {
+			return
+		}
+		authSecret = &vault.Secret{
+			Auth: &vault.SecretAuth{
+				ClientToken:   v.client.Token(),
+				LeaseDuration: int(ttl.Seconds()),
+				Renewable:     renewable,
+			},
+		}
+		v.authLock.Lock()
+		v.authSecret = authSecret
+		v.tokenExpiration = time.Now().Add(ttl)
+		v.authLock.Unlock()
+	}
+	if authSecret.Auth == nil || authSecret.Auth.LeaseDuration <= 0 {
+		return
+	}
+
+	// Renew in the background, independent of the caller's context.
+	renewCtx := context.WithValue(context.Background(), contexttype.SecretProviderID, v.ID) // nolint
+	v.backgroundWaitGroup.Add(1)
+	go v.renew(renewCtx)
+}
+
+// renew renews the Vault token until it can no longer be renewed, then authenticates again.
+func (v *Vault) renew(ctx context.Context) {
+	defer v.backgroundWaitGroup.Done()
+
+	for {
+		// Renew the current token.
+		v.authLock.Lock()
+		authSecret := v.authSecret
+		v.authLock.Unlock()
+		watcher, err := v.loginClient.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
+			Secret: authSecret,
+		})
+		if err != nil {
+			v.setRenewalError(ctx, err)
+
+			return
+		}
+		go watcher.Start()
+		closed, err := v.watch(ctx, watcher)
+		watcher.Stop()
+		if closed {
+			return
+		}
+		if err != nil {
+			v.setRenewalError(ctx, wrapError("", err))
+		}
+
+		// Static tokens cannot be replaced once they expire.
+		if v.authenticate == nil {
+			if err == nil {
+				v.setRenewalError(ctx, errors.New("Vault token cannot be renewed and will expire"))
+			}
+
+			return
+		}
+
+		// Authenticate again.
+		for {
+			err = v.login(ctx)
+			if err == nil {
+				v.setRenewalError(ctx, nil)
+
+				break
+			}
+			v.setRenewalError(ctx, err)
+			select {
+			case <-v.done:
+				return
+			case <-time.After(loginRetryInterval):
+			}
+		}
+	}
+}
+
+// watch records renewals until the watcher finishes or the provider is closed.
+func (v *Vault) watch(ctx context.Context, watcher *vault.LifetimeWatcher) (closed bool, err error) {
+	for {
+		select {
+		case <-v.done:
+			return true, nil
+		case err = <-watcher.DoneCh():
+			return false, err
+		case renewal := <-watcher.RenewCh():
+			v.authLock.Lock()
+			if renewal.Secret != nil && renewal.Secret.Auth != nil && renewal.Secret.Auth.LeaseDuration > 0 {
+				v.tokenExpiration = renewal.RenewedAt.Add(time.Duration(renewal.Secret.Auth.LeaseDuration) * time.Second)
+			}
+			v.renewalErr = nil
+			v.authLock.Unlock()
+
+			// Log.
+			logger.Verbose(ctx, "Renewed Vault token.")
+		}
+	}
+}
+
+// setRenewalError records the result of an attempt to renew or replace the Vault token.
+func (v *Vault) setRenewalError(ctx context.Context, err error) {
+	v.authLock.Lock()
+	v.renewalErr = err
+	v.authLock.Unlock()
+
+	// Log.
+	if err != nil {
+		logger.Warn(ctx, "Unable to renew Vault token: "+err.Error())
+	}
+}
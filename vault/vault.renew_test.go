@@ -0,0 +1,40 @@
+package vault
+
+import (
+	"testing"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRenewal tests renewing tokens in the background.
+func TestRenewal(t *testing.T) {
+	// Root tokens do not expire.
+	assert.True(t, vaultClient.TokenExpiration().IsZero())
+
+	// Create a renewable token.
+	tokenSecret, err := vaultClient.client.Auth().Token().CreateWithContext(ctx, &vault.TokenCreateRequest{
+		Policies:  []string{"root"},
+		Renewable: func() *bool { renewable := true; return &renewable }(),
+		TTL:       "1m",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Track the token expiration.
+	renewingClient, err := New(ctx, &secretprovidertype.SecretProvider{
+		ClientSecret: tokenSecret.Auth.ClientToken,
+		ID:           "6c8e0a85-0a57-4cf0-b1d8-6a4bbf1cc9a2",
+		Type:         "Vault",
+	})
+	if assert.NoError(t, err) {
+		expiration := renewingClient.TokenExpiration()
+		assert.WithinDuration(t, time.Now().Add(time.Minute), expiration, 5*time.Second)
+		assert.NoError(t, renewingClient.RenewalError())
+		assert.NoError(t, renewingClient.Close())
+		assert.NoError(t, renewingClient.Close())
+	}
+}
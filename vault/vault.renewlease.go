@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RenewLease renews a lease by the increment.
+// A zero increment requests the lease's default time to live.
+func (v *Vault) RenewLease(ctx context.Context, leaseID string, increment time.Duration) (*secretprovidertype.Lease, error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if leaseID == "" {
+		return nil, errors.New("lease ID is required")
+	}
+	if increment < 0 {
+		return nil, errors.New("increment cannot be negative")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Renew lease.
+	secret, err := v.client.Sys().RenewWithContext(ctx, leaseID, int(increment/time.Second))
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	lease := toLease(secret)
+	if lease == nil {
+		return nil, errors.New("Vault returned no lease")
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Renewed lease.")
+
+	return lease, nil
+}
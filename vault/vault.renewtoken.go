@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RenewToken renews a token by the increment.
+// A zero increment requests the token's default time to live.
+func (v *Vault) RenewToken(ctx context.Context, token string, increment time.Duration) (*secretprovidertype.Token, error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if token == "" {
+		return nil, errors.New("token is required")
+	}
+	if increment < 0 {
+		return nil, errors.New("increment cannot be negative")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Renew token.
+	secret, err := v.client.Auth().Token().RenewWithContext(ctx, token, int(increment/time.Second))
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	result, err := toToken(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	logger.Info(ctx, "Renewed token.")
+
+	return result, nil
+}
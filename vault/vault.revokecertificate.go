@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+)
+
+// RevokeCertificate revokes a certificate issued by the PKI secrets engine.
+func (v *Vault) RevokeCertificate(ctx context.Context, serialNumber string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if serialNumber == "" {
+		return errors.New("serial number is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Revoke certificate.
+	_, err := v.client.Logical().WriteWithContext(ctx, v.pkiMount+"/revoke", map[string]interface{}{
+		"serial_number": serialNumber,
+	})
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Revoked certificate: "+serialNumber)
+	} else {
+		logger.Info(ctx, "Revoked certificate.")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// RevokeLease revokes a lease, invalidating its secret, and stops renewing it if it is watched.
+func (v *Vault) RevokeLease(ctx context.Context, leaseID string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if leaseID == "" {
+		return errors.New("lease ID is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Revoke lease.
+	v.unwatchLease(leaseID)
+	err := v.client.Sys().RevokeWithContext(ctx, leaseID)
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	logger.Info(ctx, "Revoked lease.")
+
+	return nil
+}
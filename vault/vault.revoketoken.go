@@ -0,0 +1,34 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// RevokeToken revokes a token and its child tokens.
+func (v *Vault) RevokeToken(ctx context.Context, token string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if token == "" {
+		return errors.New("token is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Revoke token.
+	err := v.client.Auth().Token().RevokeTreeWithContext(ctx, token)
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	logger.Info(ctx, "Revoked token.")
+
+	return nil
+}
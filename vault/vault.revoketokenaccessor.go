@@ -0,0 +1,34 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+)
+
+// RevokeTokenAccessor revokes a token and its child tokens by its accessor.
+func (v *Vault) RevokeTokenAccessor(ctx context.Context, accessor string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if accessor == "" {
+		return errors.New("accessor is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Revoke token.
+	err := v.client.Auth().Token().RevokeAccessorWithContext(ctx, accessor)
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	logger.Info(ctx, "Revoked token.")
+
+	return nil
+}
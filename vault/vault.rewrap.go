@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Rewrap re-encrypts ciphertext with the latest version of a named key in the transit secrets engine, without exposing the plaintext.
+func (v *Vault) Rewrap(ctx context.Context, name string, ciphertext string) (newCiphertext string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return "", err
+	}
+	if ciphertext == "" {
+		return "", errors.New("ciphertext is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Rewrap.
+	data, err := v.writeTransit(ctx, "rewrap", name, map[string]interface{}{
+		"ciphertext": ciphertext,
+	})
+	if err != nil {
+		return "", err
+	}
+	newCiphertext, err = transitString(data, "ciphertext")
+	if err != nil {
+		return "", err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Rewrapped data.")
+
+	return newCiphertext, nil
+}
@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+)
+
+// RollbackSecret restores a previous version of a secret by writing its data as a new version.
+func (v *Vault) RollbackSecret(ctx context.Context, path string, version string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if path == "" {
+		return errors.New("path is required")
+	}
+	versionNumber, err := parseVersion(version)
+	if err != nil {
+		return err
+	}
+	path, err = v.secretPath(path)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Roll back secret.
+	err = v.requireKVv2(ctx, path)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.KVv2(v.mount).Rollback(ctx, v.kvPath(path), versionNumber)
+	if err != nil {
+		return wrapError(path, err)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Rolled back secret: "+path)
+	} else {
+		logger.Info(ctx, "Rolled back secret.")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// RotateEncryptionKey rotates a named key in the transit secrets engine.
+// Data encrypted with previous versions of the key can still be decrypted, and can be rewrapped with the new version.
+func (v *Vault) RotateEncryptionKey(ctx context.Context, name string) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	err := secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Rotate key.
+	_, err = v.client.Logical().WriteWithContext(ctx, v.transitMount+"/keys/"+name+"/rotate", nil)
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	logger.Info(ctx, "Rotated encryption key.")
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// Interval between checks of the seal status.
+	sealCheckInterval = 30 * time.Second
+
+	// Bounds of the interval between checks while Vault is sealed.
+	sealRetryMinInterval = time.Second
+	sealRetryMaxInterval = time.Minute
+)
+
+// startSealMonitor starts checking whether Vault nodes are sealed, unsealing them with the unseal shards, until Close is called.
+func (v *Vault) startSealMonitor(unsealShards []string) {
+	monitorCtx := context.WithValue(context.Background(), contexttype.SecretProviderID, v.ID) // nolint
+	v.backgroundWaitGroup.Add(1)
+	go v.monitorSeal(monitorCtx, unsealShards)
+}
+
+// monitorSeal periodically checks the seal status and selects the active Vault address, or immediately once a request is refused, backing off while Vault remains sealed.
+func (v *Vault) monitorSeal(ctx context.Context, unsealShards []string) {
+	defer v.backgroundWaitGroup.Done()
+
+	interval := sealCheckInterval
+	retryInterval := time.Duration(0)
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-v.done:
+			return
+		case <-v.sealSignal:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-timer.C:
+		}
+
+		// Check and unseal nodes, then select the active node, which may have changed or recovered.
+		sealed := v.checkSeal(ctx, unsealShards)
+		v.selectAddress(ctx)
+		if sealed {
+			retryInterval *= 2
+			if retryInterval < sealRetryMinInterval {
+				retryInterval = sealRetryMinInterval
+			}
+			if retryInterval > sealRetryMaxInterval {
+				retryInterval = sealRetryMaxInterval
+			}
+			interval = retryInterval
+		} else {
+			retryInterval = 0
+			interval = sealCheckInterval
+		}
+		timer.Reset(interval)
+	}
+}
+
+// checkSeal unseals sealed Vault nodes with the unseal shards, returning whether any reachable node remains sealed.
+func (v *Vault) checkSeal(ctx context.Context, unsealShards []string) (sealed bool) {
+	for _, nodeContext := range v.nodeContexts(ctx) {
+		status, err := v.sysClient.Sys().SealStatusWithContext(nodeContext)
+		if err != nil || !status.Sealed {
+			continue
+		}
+		if len(unsealShards) == 0 {
+			logger.Warn(ctx, "Vault is sealed and no unseal shards are configured.")
+			sealed = true
+
+			continue
+		}
+		logger.Warn(ctx, "Vault is sealed; unsealing.")
+		err = v.unsealNode(nodeContext, unsealShards)
+		if err != nil {
+			logger.Warn(ctx, "Unable to unseal Vault: "+err.Error())
+			sealed = true
+
+			continue
+		}
+		logger.Info(ctx, "Unsealed Vault.")
+	}
+
+	return sealed
+}
+
+// detectSeal is a response callback that checks the seal status as soon as Vault refuses a request as unavailable.
+func (v *Vault) detectSeal(response *vault.Response) {
+	if response.StatusCode != http.StatusServiceUnavailable {
+		return
+	}
+	select {
+	case v.sealSignal <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Sign signs input with a named key in the transit secrets engine.
+func (v *Vault) Sign(ctx context.Context, name string, input []byte) (signature string, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return "", errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return "", err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Sign.
+	data, err := v.writeTransit(ctx, "sign", name, map[string]interface{}{
+		"input": base64.StdEncoding.EncodeToString(input),
+	})
+	if err != nil {
+		return "", err
+	}
+	signature, err = transitString(data, "signature")
+	if err != nil {
+		return "", err
+	}
+
+	// Log.
+	logger.Verbose(ctx, "Signed data.")
+
+	return signature, nil
+}
@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+)
+
+// SignCertificate issues a certificate for a certificate signing request from the PKI secrets engine.
+// The private key never leaves the caller, so the returned certificate has no private key.
+func (v *Vault) SignCertificate(ctx context.Context, csr *x509.CertificateRequest, request *CertificateRequest) (certificate *Certificate, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if csr == nil || len(csr.Raw) == 0 {
+		return nil, errors.New("certificate signing request is required")
+	}
+	if request == nil {
+		request = &CertificateRequest{}
+	}
+	role, err := v.certificateRole(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Sign certificate.
+	data := certificateRequestData(request)
+	data["csr"] = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: csr.Raw,
+	}))
+	if _, ok := data["common_name"]; !ok && csr.Subject.CommonName != "" {
+		data["common_name"] = csr.Subject.CommonName
+	}
+	secret, err := v.client.Logical().WriteWithContext(ctx, v.pkiMount+"/sign/"+role, data)
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	if secret == nil {
+		return nil, errors.New("Vault returned no certificate")
+	}
+	certificate, err = parseCertificateResponse(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Signed certificate: "+certificate.SerialNumber)
+	} else {
+		logger.Info(ctx, "Signed certificate.")
+	}
+
+	return certificate, nil
+}
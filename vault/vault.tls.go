@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bertjohnson/logger"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// configureTLS applies the TLS settings of a secret provider to a Vault client configuration.
+// Settings not specified by the secret provider keep the values read from Vault's environment variables.
+func configureTLS(ctx context.Context, vaultConfig *vault.Config, secretProvider *secretprovidertype.SecretProvider) error {
+	// Validate settings.
+	if (secretProvider.TLSClientCert == "") != (secretProvider.TLSClientKey == "") {
+		return errors.New("TLS client certificate and key must be specified together")
+	}
+	var minVersion uint16
+	if secretProvider.TLSMinVersion != "" {
+		var err error
+		minVersion, err = parseTLSVersion(secretProvider.TLSMinVersion)
+		if err != nil {
+			return err
+		}
+	}
+	if !secretProvider.TLSSkipVerify {
+		secretProvider.TLSSkipVerify, _ = strconv.ParseBool(os.Getenv(env.VaultSkipVerify)) // nolint
+	}
+
+	// Configure certificates.
+	if secretProvider.TLSCACert != "" || secretProvider.TLSClientCert != "" || secretProvider.TLSServerName != "" || secretProvider.TLSSkipVerify {
+		if secretProvider.TLSSkipVerify {
+			logger.Info(ctx, "Skipping Vault TLS certificate verification.")
+		}
+		err := vaultConfig.ConfigureTLS(&vault.TLSConfig{
+			CACert:        secretProvider.TLSCACert,
+			ClientCert:    secretProvider.TLSClientCert,
+			ClientKey:     secretProvider.TLSClientKey,
+			Insecure:      secretProvider.TLSSkipVerify,
+			TLSServerName: secretProvider.TLSServerName,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Configure minimum version.
+	if minVersion != 0 {
+		transport, ok := vaultConfig.HttpClient.Transport.(*http.Transport)
+		if !ok {
+			return errors.New("unable to configure TLS version of Vault HTTP client")
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = new(tls.Config)
+		}
+		transport.TLSClientConfig.MinVersion = minVersion
+	}
+
+	return nil
+}
+
+// parseTLSVersion parses a TLS version (e.g., "1.2" or "TLS1.3").
+func parseTLSVersion(version string) (uint16, error) {
+	switch strings.TrimPrefix(strings.ToLower(strings.ReplaceAll(version, " ", "")), "tls") {
+	case "1.0", "10":
+		return tls.VersionTLS10, nil
+	case "1.1", "11":
+		return tls.VersionTLS11, nil
+	case "1.2", "12":
+		return tls.VersionTLS12, nil
+	case "1.3", "13":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, errors.New("unsupported TLS version: " + version)
+	}
+}
@@ -0,0 +1,33 @@
+package vault
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConfigureTLS tests configureTLS().
+func TestConfigureTLS(t *testing.T) {
+	// Configure the server name and minimum version.
+	vaultConfig := vault.DefaultConfig()
+	err := configureTLS(ctx, vaultConfig, &secretprovidertype.SecretProvider{
+		TLSMinVersion: "1.3",
+		TLSServerName: "vault.example.com",
+	})
+	assert.NoError(t, err)
+	tlsConfig := vaultConfig.HttpClient.Transport.(*http.Transport).TLSClientConfig
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+	assert.Equal(t, "vault.example.com", tlsConfig.ServerName)
+
+	// Reject invalid settings.
+	err = configureTLS(ctx, vault.DefaultConfig(), &secretprovidertype.SecretProvider{TLSMinVersion: "2.0"})
+	assert.Error(t, err)
+	err = configureTLS(ctx, vault.DefaultConfig(), &secretprovidertype.SecretProvider{TLSClientCert: "client.pem"})
+	assert.Error(t, err)
+	err = configureTLS(ctx, vault.DefaultConfig(), &secretprovidertype.SecretProvider{TLSCACert: "missing.pem"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// toToken converts a Vault token secret, from either token creation or lookup, to a Token.
+func toToken(secret *vault.Secret) (*secretprovidertype.Token, error) {
+	if secret == nil {
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, "", errors.New("token not found"))
+	}
+	token := &secretprovidertype.Token{}
+	token.ID, _ = secret.TokenID()                 // nolint
+	token.Accessor, _ = secret.TokenAccessor()     // nolint
+	token.Metadata, _ = secret.TokenMetadata()     // nolint
+	token.Policies, _ = secret.TokenPolicies()     // nolint
+	token.Renewable, _ = secret.TokenIsRenewable() // nolint
+	token.TTL, _ = secret.TokenTTL()               // nolint
+	if secret.Auth != nil {
+		token.Orphan = secret.Auth.Orphan
+	} else if orphan, ok := secret.Data["orphan"].(bool); ok {
+		token.Orphan = orphan
+	}
+	if token.TTL > 0 {
+		expiration := time.Now().Add(token.TTL)
+		token.Expiration = &expiration
+	}
+
+	return token, nil
+}
+
+// durationString formats a duration as a number of seconds understood by Vault, or an empty string if it is not set.
+func durationString(duration time.Duration) string {
+	if duration <= 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(int64(duration/time.Second), 10) + "s"
+}
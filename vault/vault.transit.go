@@ -0,0 +1,37 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Default mount of the transit secrets engine.
+const defaultTransitMount = "transit"
+
+// Vault implements encryption using the transit secrets engine.
+var _ secretprovidertype.IEncryptionProvider = (*Vault)(nil)
+
+// writeTransit writes to an endpoint of the transit secrets engine for a key, returning the response data.
+func (v *Vault) writeTransit(ctx context.Context, endpoint string, name string, data map[string]interface{}) (map[string]interface{}, error) {
+	secret, err := v.client.Logical().WriteWithContext(ctx, v.transitMount+"/"+endpoint+"/"+name, data)
+	if err != nil {
+		return nil, wrapError("", err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, errors.New("Vault returned no data from transit endpoint: " + endpoint)
+	}
+
+	return secret.Data, nil
+}
+
+// transitString returns a string field of transit response data.
+func transitString(data map[string]interface{}, field string) (string, error) {
+	value, ok := data[field].(string)
+	if !ok {
+		return "", errors.New("Vault returned no " + field)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// unseal unseals each Vault node with the unseal shards, succeeding if at least one node is unsealed.
+func (v *Vault) unseal(ctx context.Context, unsealShards []string) error {
+	var (
+		lastErr  error
+		unsealed bool
+	)
+	for _, nodeContext := range v.nodeContexts(ctx) {
+		err := v.unsealNode(nodeContext, unsealShards)
+		if err != nil {
+			lastErr = err
+			if v.failover != nil {
+				logger.Warn(ctx, "Unable to unseal Vault node: "+err.Error())
+			}
+
+			continue
+		}
+		unsealed = true
+	}
+	if !unsealed {
+		return lastErr
+	}
+
+	return nil
+}
+
+// unsealNode unseals the Vault node selected by ctx with the unseal shards.
+func (v *Vault) unsealNode(ctx context.Context, unsealShards []string) error {
+	var (
+		err  error
+		resp *vault.SealStatusResponse
+	)
+	for _, unsealShard := range unsealShards {
+		if os.Getenv(env.Debug) != "" {
+			logger.Verbose(ctx, "Unsealing using shard: "+unsealShard)
+		}
+		resp, err = v.sysClient.Sys().UnsealWithContext(ctx, unsealShard)
+		if err != nil {
+			return wrapError("", err)
+		}
+	}
+	if resp == nil {
+		resp, err = v.sysClient.Sys().SealStatusWithContext(ctx)
+		if err != nil {
+			return wrapError("", err)
+		}
+	}
+	if resp.Sealed {
+		return secretprovidertype.NewError(secretprovidertype.ErrSealed, "", errors.New("Vault is sealed"))
+	}
+
+	return nil
+}
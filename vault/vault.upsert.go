@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"os"
-	"strings"
 
 	"github.com/bertjohnson/logger"
 	contexttype "github.com/bertjohnson/logger/types/context"
@@ -20,15 +19,16 @@ func (v *Vault) UpsertSecret(ctx context.Context, path string, data map[string]i
 	if path == "" {
 		return errors.New("path is required")
 	}
-	if !strings.HasPrefix(path, "secret/") {
-		path = "secret/" + path
+	path, err := v.secretPath(path)
+	if err != nil {
+		return err
 	}
 
 	// Add to context.
 	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
 
 	// Create secret.
-	_, err := v.client.Logical().Write(path, data)
+	err = v.writeKV(ctx, path, data)
 	if err != nil {
 		return err
 	}
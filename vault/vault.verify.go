@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// Verify verifies the signature of input with a named key in the transit secrets engine.
+func (v *Vault) Verify(ctx context.Context, name string, input []byte, signature string) (valid bool, err error) {
+	// Validate parameters.
+	if ctx == nil {
+		return false, errors.New("context is required")
+	}
+	err = secretprovidertype.ValidateKeyName(name)
+	if err != nil {
+		return false, err
+	}
+	if signature == "" {
+		return false, errors.New("signature is required")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Verify.
+	data, err := v.writeTransit(ctx, "verify", name, map[string]interface{}{
+		"input":     base64.StdEncoding.EncodeToString(input),
+		"signature": signature,
+	})
+	if err != nil {
+		return false, err
+	}
+	valid, _ = data["valid"].(bool) // nolint
+
+	// Log.
+	logger.Verbose(ctx, "Verified signature.")
+
+	return valid, nil
+}
@@ -0,0 +1,30 @@
+package vault
+
+import (
+	"context"
+	"errors"
+
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// WatchedLease returns the current lease of a watched secret, including renewals made in the background.
+func (v *Vault) WatchedLease(ctx context.Context, leaseID string) (*secretprovidertype.Lease, error) {
+	// Validate parameters.
+	if ctx == nil {
+		return nil, errors.New("context is required")
+	}
+	if leaseID == "" {
+		return nil, errors.New("lease ID is required")
+	}
+
+	// Copy lease.
+	v.leaseLock.Lock()
+	defer v.leaseLock.Unlock()
+	lease, ok := v.leases[leaseID]
+	if !ok {
+		return nil, secretprovidertype.NewError(secretprovidertype.ErrNotFound, "", errors.New("lease is not watched"))
+	}
+	current := *lease.lease
+
+	return &current, nil
+}
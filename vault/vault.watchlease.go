@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// WatchLease renews the lease of a secret in the background until it can no longer be renewed, then calls fn.
+// The secret is not modified; the current lease after renewals is returned by WatchedLease.
+// Watched leases are revoked when RevokeLease or Close is called.
+func (v *Vault) WatchLease(ctx context.Context, secret *secretprovidertype.Secret, fn LeaseFunc) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if secret == nil {
+		return errors.New("secret is required")
+	}
+	if secret.Lease == nil || secret.Lease.ID == "" {
+		return errors.New("secret has no lease")
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Create watcher.
+	// Renewal errors stop renewable leases so that fn learns why; non-renewable leases are watched until shortly before they expire.
+	renewBehavior := vault.RenewBehaviorIgnoreErrors
+	if secret.Lease.Renewable {
+		renewBehavior = vault.RenewBehaviorErrorOnErrors
+	}
+	watcher, err := v.client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
+		RenewBehavior: renewBehavior,
+		Secret: &vault.Secret{
+			LeaseDuration: int(secret.Lease.Duration.Seconds()),
+			LeaseID:       secret.Lease.ID,
+			Renewable:     secret.Lease.Renewable,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Register lease.
+	current := *secret.Lease
+	lease := &watchedLease{
+		lease: &current,
+		path:  secret.Path,
+		stop:  make(chan struct{}),
+	}
+	v.leaseLock.Lock()
+	select {
+	case <-v.done:
+		v.leaseLock.Unlock()
+
+		return errors.New("Vault client is closed")
+	default:
+	}
+	if _, ok := v.leases[secret.Lease.ID]; ok {
+		v.leaseLock.Unlock()
+
+		return secretprovidertype.NewError(secretprovidertype.ErrAlreadyExists, secret.Path, errors.New("lease is already watched"))
+	}
+	v.leases[secret.Lease.ID] = lease
+	v.backgroundWaitGroup.Add(1)
+	v.leaseLock.Unlock()
+
+	// Renew in the background, independent of the caller's context.
+	watchCtx := context.WithValue(context.Background(), contexttype.SecretProviderID, v.ID) // nolint
+	go v.watchLease(watchCtx, secret, lease, watcher, fn)
+
+	// Log.
+	logger.Verbose(ctx, "Watching lease.")
+
+	return nil
+}
+
+// watchLease renews a lease until it can no longer be renewed, it is revoked or the provider is closed.
+func (v *Vault) watchLease(ctx context.Context, secret *secretprovidertype.Secret, lease *watchedLease, watcher *vault.LifetimeWatcher, fn LeaseFunc) {
+	defer v.backgroundWaitGroup.Done()
+	go watcher.Start()
+	defer watcher.Stop()
+	leaseID := lease.lease.ID
+
+	for {
+		select {
+		case <-v.done:
+			return
+		case <-lease.stop:
+			return
+		case err := <-watcher.DoneCh():
+			v.unwatchLease(leaseID)
+			err = wrapError(lease.path, err)
+
+			// Log.
+			if err != nil {
+				logger.Warn(ctx, "Unable to renew lease: "+err.Error())
+			} else {
+				logger.Info(ctx, "Lease can no longer be renewed.")
+			}
+
+			// Notify.
+			if fn != nil {
+				fn(secret, err)
+			}
+
+			return
+		case renewal := <-watcher.RenewCh():
+			// Update lease.
+			if renewal != nil && renewal.Secret != nil {
+				renewedLease := &secretprovidertype.Lease{
+					Duration:  time.Duration(renewal.Secret.LeaseDuration) * time.Second,
+					ID:        leaseID,
+					Renewable: renewal.Secret.Renewable,
+				}
+				if renewedLease.Duration > 0 {
+					expiration := renewal.RenewedAt.Add(renewedLease.Duration)
+					renewedLease.Expiration = &expiration
+				}
+				v.leaseLock.Lock()
+				lease.lease = renewedLease
+				v.leaseLock.Unlock()
+			}
+
+			// Log.
+			logger.Verbose(ctx, "Renewed lease.")
+		}
+	}
+}
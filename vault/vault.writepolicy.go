@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/bertjohnson/logger"
+	contexttype "github.com/bertjohnson/logger/types/context"
+	"github.com/bertjohnson/logger/types/env"
+	secretprovidertype "github.com/bertjohnson/secretprovider/types"
+)
+
+// WritePolicy creates or replaces an ACL policy.
+func (v *Vault) WritePolicy(ctx context.Context, policy *secretprovidertype.Policy) error {
+	// Validate parameters.
+	if ctx == nil {
+		return errors.New("context is required")
+	}
+	if policy == nil {
+		return errors.New("policy is required")
+	}
+	err := policy.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Add to context.
+	ctx = context.WithValue(ctx, contexttype.SecretProviderID, v.ID) // nolint
+
+	// Write policy.
+	err = v.client.Sys().PutPolicyWithContext(ctx, policy.Name, renderPolicy(policy))
+	if err != nil {
+		return wrapError("", err)
+	}
+
+	// Log.
+	if os.Getenv(env.Debug) != "" {
+		logger.Verbose(ctx, "Wrote policy: "+policy.Name)
+	} else {
+		logger.Info(ctx, "Wrote policy.")
+	}
+
+	return nil
+}
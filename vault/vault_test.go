@@ -52,6 +52,7 @@ func TestMain(m *testing.M) {
 
 	// Run tests.
 	retCode := m.Run()
+	vaultClient.Close()
 
 	// Kill local Vault development server after tests have run.
 	if vaultCommand.Process != nil {